@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+)
+
+// DefaultAccountingJobWindow is the time window of each page when none is given.
+const DefaultAccountingJobWindow = 24 * time.Hour
+
+// ListV0045AccountingJobPages lists the slurmdbd job history matching query one
+// time window at a time, calling fn with each non-empty page, so that only one
+// window of jobs is held in memory. The query must have a start time, a zero
+// end time means now. A job overlapping several windows is only returned once,
+// in the window it became eligible in, or the first one when it has no begin
+// time. Paging stops at the first error, including one returned by fn.
+func ListV0045AccountingJobPages(
+	ctx context.Context,
+	r Reader,
+	query *types.V0045AccountingJobQuery,
+	window time.Duration,
+	fn func(page *types.V0045AccountingJobList) error,
+) error {
+	if query == nil || query.StartTime.IsZero() {
+		return errors.New("query must have a start time")
+	}
+	if window <= 0 {
+		window = DefaultAccountingJobWindow
+	}
+
+	query = query.DeepCopy()
+	if query.EndTime.IsZero() {
+		query.EndTime = time.Now()
+	}
+
+	queries := query.Split(window)
+	seen := map[accountingJobKey]bool{}
+	for i, q := range queries {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		page := &types.V0045AccountingJobList{}
+		if err := r.List(ctx, page, &ListOptions{Params: q}); err != nil {
+			return err
+		}
+
+		// slurmdbd returns every job overlapping the window, inclusive of
+		// both ends. Windows are half-open, except the last, and a job is
+		// only returned once across all of them.
+		last := i == len(queries)-1
+		items := page.Items[:0]
+		for _, item := range page.Items {
+			begin := item.GetBeginTime()
+			if !last && !begin.IsZero() && !begin.Before(q.EndTime) {
+				continue
+			}
+			key := newAccountingJobKey(&item)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			items = append(items, item)
+		}
+		page.Items = items
+
+		if len(page.Items) == 0 {
+			continue
+		}
+		if err := fn(page); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// accountingJobKey identifies a job record, as job ids are only unique within a
+// cluster and may be reused.
+type accountingJobKey struct {
+	cluster    string
+	jobId      int32
+	submission int64
+}
+
+func newAccountingJobKey(job *types.V0045AccountingJob) accountingJobKey {
+	key := accountingJobKey{
+		cluster: ptr.Deref(job.Cluster, ""),
+		jobId:   ptr.Deref(job.JobId, 0),
+	}
+	if job.Time != nil {
+		key.submission = ptr.Deref(job.Time.Submission, 0)
+	}
+	return key
+}
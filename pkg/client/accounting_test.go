@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+// accountingJobReader mimics slurmdbd, returning every job overlapping the
+// time window of the query, inclusive of both ends.
+type accountingJobReader struct {
+	jobs  []types.V0045AccountingJob
+	calls int
+}
+
+func (r *accountingJobReader) Get(ctx context.Context, key object.ObjectKey, obj object.Object, opts ...GetOption) error {
+	return errors.New("not implemented")
+}
+
+func (r *accountingJobReader) List(ctx context.Context, list object.ObjectList, opts ...ListOption) error {
+	r.calls++
+	options := &ListOptions{}
+	options.ApplyOptions(opts)
+	query := options.Params.(*types.V0045AccountingJobQuery)
+	out := list.(*types.V0045AccountingJobList)
+	for _, job := range r.jobs {
+		end := time.Unix(*job.Time.End, 0)
+		if !job.GetBeginTime().After(query.EndTime) && !end.Before(query.StartTime) {
+			out.Items = append(out.Items, *job.DeepCopy())
+		}
+	}
+	return nil
+}
+
+func newTestAccountingJob(cluster string, jobId int32, eligible, end time.Time) types.V0045AccountingJob {
+	out := types.V0045AccountingJob{}
+	utils.RemarshalOrDie(api.V0045Job{Cluster: &cluster, JobId: &jobId}, &out)
+	utils.RemarshalOrDie(map[string]any{
+		"time": map[string]any{
+			"submission": eligible.Unix(),
+			"eligible":   eligible.Unix(),
+			"end":        end.Unix(),
+		},
+	}, &out)
+	return out
+}
+
+func TestListV0045AccountingJobPages(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	hour := func(n int) time.Time {
+		return start.Add(time.Duration(n) * time.Hour)
+	}
+	jobs := []types.V0045AccountingJob{
+		newTestAccountingJob("cluster", 1, hour(0), hour(1)),
+		newTestAccountingJob("cluster", 2, hour(0), hour(3)),
+		newTestAccountingJob("cluster", 3, hour(2), hour(2)),
+		newTestAccountingJob("cluster", 4, hour(1), hour(1)),
+		newTestAccountingJob("cluster", 5, time.Unix(0, 0), hour(2)),
+		// Job ids are only unique within a cluster, and may be reused.
+		newTestAccountingJob("other", 1, hour(2), hour(2)),
+		newTestAccountingJob("cluster", 2, hour(3), hour(3)),
+	}
+	type args struct {
+		query  *types.V0045AccountingJobQuery
+		window time.Duration
+		fnErr  error
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantPages [][]string
+		wantCalls int
+		wantErr   bool
+	}{
+		{
+			name: "missing start time",
+			args: args{
+				query:  types.NewV0045AccountingJobQuery(),
+				window: time.Hour,
+			},
+			wantErr: true,
+		},
+		{
+			name: "single window",
+			args: args{
+				query:  types.NewV0045AccountingJobQuery().Between(hour(0), hour(4)),
+				window: 4 * time.Hour,
+			},
+			wantPages: [][]string{{"1", "2", "3", "4", "5", "1", "2"}},
+			wantCalls: 1,
+		},
+		{
+			name: "jobs are only returned once",
+			args: args{
+				query:  types.NewV0045AccountingJobQuery().Between(hour(0), hour(4)),
+				window: time.Hour,
+			},
+			wantPages: [][]string{{"1", "2", "5"}, {"4"}, {"3", "1"}, {"2"}},
+			wantCalls: 4,
+		},
+		{
+			name: "fn error stops paging",
+			args: args{
+				query:  types.NewV0045AccountingJobQuery().Between(hour(0), hour(4)),
+				window: time.Hour,
+				fnErr:  errors.New("stop"),
+			},
+			wantPages: [][]string{{"1", "2", "5"}},
+			wantCalls: 1,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &accountingJobReader{jobs: jobs}
+			pages := [][]string{}
+			fn := func(page *types.V0045AccountingJobList) error {
+				keys := []string{}
+				for _, item := range page.Items {
+					keys = append(keys, string(item.GetKey()))
+				}
+				pages = append(pages, keys)
+				return tt.args.fnErr
+			}
+			err := ListV0045AccountingJobPages(context.Background(), r, tt.args.query, tt.args.window, fn)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ListV0045AccountingJobPages() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantPages != nil {
+				require.Equal(t, tt.wantPages, pages)
+			}
+			require.Equal(t, tt.wantCalls, r.calls)
+		})
+	}
+}
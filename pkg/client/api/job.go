@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+// JobResult is the result of an operation on a single job.
+type JobResult struct {
+	// JobId is the ID of the job.
+	JobId int32
+
+	// StepId is the ID of the job step, if the result is for a step.
+	StepId string
+
+	// Error is the error reported for the job, nil on success.
+	Error error
+}
+
+// RequeueFlags modify how jobs are requeued.
+type RequeueFlags struct {
+	// Hold holds the job after requeue, requiring a manual release to run again.
+	Hold bool
+
+	// SpecialExit sets the SPECIAL_EXIT state after requeue, requires Hold.
+	SpecialExit bool
+
+	// Incomplete only requeues jobs (or tasks of a job array) which have not completed.
+	Incomplete bool
+}
@@ -0,0 +1,13 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+// ReservationResult is the result of an operation on a single reservation.
+type ReservationResult struct {
+	// Name is the name of the reservation.
+	Name string
+
+	// Error is the error reported for the reservation, nil on success.
+	Error error
+}
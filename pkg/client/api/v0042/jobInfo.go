@@ -35,11 +35,11 @@ func (c *SlurmClient) CreateJobInfo(ctx context.Context, req any) (*int32, error
 	if err != nil {
 		return nil, err
 	} else if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0042OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return nil, errors.Join(errs...)
+		return nil, newSlurmError(res.StatusCode(), errs)
 	}
 	return res.JSON200.JobId, nil
 }
@@ -53,11 +53,11 @@ func (c *SlurmClient) DeleteJobInfo(ctx context.Context, jobId string) error {
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0042OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return errors.Join(errs...)
+		return newSlurmError(res.StatusCode(), errs)
 	}
 
 	return nil
@@ -76,11 +76,11 @@ func (c *SlurmClient) UpdateJobInfo(ctx context.Context, jobId string, req any)
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0042OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return errors.Join(errs...)
+		return newSlurmError(res.StatusCode(), errs)
 	}
 
 	return nil
@@ -95,11 +95,11 @@ func (c *SlurmClient) GetJobInfo(ctx context.Context, jobId string) (*types.V004
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0042OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return nil, errors.Join(errs...)
+		return nil, newSlurmError(res.StatusCode(), errs)
 	}
 
 	if len(res.JSON200.Jobs) == 0 {
@@ -120,11 +120,11 @@ func (c *SlurmClient) ListJobInfo(ctx context.Context) (*types.V0042JobInfoList,
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0042OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return nil, errors.Join(errs...)
+		return nil, newSlurmError(res.StatusCode(), errs)
 	}
 
 	list := &types.V0042JobInfoList{
@@ -0,0 +1,260 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package v0042
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0042"
+	"github.com/SlinkyProject/slurm-client/pkg/client/api/v0042/fake"
+	"github.com/SlinkyProject/slurm-client/pkg/client/api/v0042/interceptor"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+)
+
+func TestSlurmClient_GetLicense(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx  context.Context
+		name string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *types.V0042License
+		wantErr bool
+	}{
+		{
+			name: "Not Found",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "license-0",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Found",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0042GetLicensesWithResponse: func(ctx context.Context, reqEditors ...api.RequestEditorFn) (*api.SlurmV0042GetLicensesResponse, error) {
+							res := &api.SlurmV0042GetLicensesResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0042OpenapiLicensesResp{
+									Licenses: []api.V0042License{
+										{LicenseName: ptr.To("license-0")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "license-0",
+			},
+			want: &types.V0042License{
+				V0042License: api.V0042License{
+					LicenseName: ptr.To("license-0"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0042GetLicensesWithResponse: func(ctx context.Context, reqEditors ...api.RequestEditorFn) (*api.SlurmV0042GetLicensesResponse, error) {
+							res := &api.SlurmV0042GetLicensesResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0042OpenapiLicensesResp{
+									Errors: &[]api.V0042OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "license-0",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP Error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0042GetLicensesWithResponse: func(ctx context.Context, reqEditors ...api.RequestEditorFn) (*api.SlurmV0042GetLicensesResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "license-0",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			got, err := c.GetLicense(tt.args.ctx, tt.args.name)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.GetLicense() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSlurmClient_ListLicenses(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *types.V0042LicenseList
+		wantErr bool
+	}{
+		{
+			name: "Empty list",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: &types.V0042LicenseList{
+				Items: make([]types.V0042License, 0),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Non-empty list",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0042GetLicensesWithResponse: func(ctx context.Context, reqEditors ...api.RequestEditorFn) (*api.SlurmV0042GetLicensesResponse, error) {
+							res := &api.SlurmV0042GetLicensesResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0042OpenapiLicensesResp{
+									Licenses: []api.V0042License{
+										{LicenseName: ptr.To("license-0")},
+										{LicenseName: ptr.To("license-1")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: &types.V0042LicenseList{
+				Items: []types.V0042License{
+					{V0042License: api.V0042License{LicenseName: ptr.To("license-0")}},
+					{V0042License: api.V0042License{LicenseName: ptr.To("license-1")}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0042GetLicensesWithResponse: func(ctx context.Context, reqEditors ...api.RequestEditorFn) (*api.SlurmV0042GetLicensesResponse, error) {
+							res := &api.SlurmV0042GetLicensesResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0042OpenapiLicensesResp{
+									Errors: &[]api.V0042OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0042GetLicensesWithResponse: func(ctx context.Context, reqEditors ...api.RequestEditorFn) (*api.SlurmV0042GetLicensesResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			got, err := c.ListLicenses(tt.args.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.ListLicenses() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -29,11 +29,11 @@ func (c *SlurmClient) DeleteNode(ctx context.Context, nodeName string) error {
 	if err != nil {
 		return err
 	} else if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0042OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return errors.Join(errs...)
+		return newSlurmError(res.StatusCode(), errs)
 	}
 	return nil
 }
@@ -49,11 +49,11 @@ func (c *SlurmClient) UpdateNode(ctx context.Context, nodeName string, req any)
 	if err != nil {
 		return err
 	} else if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0042OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return errors.Join(errs...)
+		return newSlurmError(res.StatusCode(), errs)
 	}
 	return nil
 }
@@ -67,11 +67,11 @@ func (c *SlurmClient) GetNode(ctx context.Context, nodeName string) (*types.V004
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0042OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return nil, errors.Join(errs...)
+		return nil, newSlurmError(res.StatusCode(), errs)
 	}
 
 	if len(res.JSON200.Nodes) == 0 {
@@ -90,11 +90,11 @@ func (c *SlurmClient) ListNodes(ctx context.Context) (*types.V0042NodeList, erro
 	if err != nil {
 		return nil, err
 	} else if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0042OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return nil, errors.Join(errs...)
+		return nil, newSlurmError(res.StatusCode(), errs)
 	}
 	list := &types.V0042NodeList{
 		Items: make([]types.V0042Node, len(res.JSON200.Nodes)),
@@ -5,7 +5,6 @@ package v0042
 
 import (
 	"context"
-	"errors"
 	"net/http"
 
 	api "github.com/SlinkyProject/slurm-client/api/v0042"
@@ -30,11 +29,11 @@ func (c *SlurmClient) GetPartitionInfo(ctx context.Context, name string) (*types
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0042OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return nil, errors.Join(errs...)
+		return nil, newSlurmError(res.StatusCode(), errs)
 	}
 
 	if len(res.JSON200.Partitions) == 0 {
@@ -55,11 +54,11 @@ func (c *SlurmClient) ListPartitionInfo(ctx context.Context) (*types.V0042Partit
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0042OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return nil, errors.Join(errs...)
+		return nil, newSlurmError(res.StatusCode(), errs)
 	}
 
 	list := &types.V0042PartitionInfoList{
@@ -5,11 +5,11 @@ package v0043
 
 import (
 	"context"
-	"errors"
 	"net/http"
 
 	"k8s.io/utils/ptr"
 
+	api "github.com/SlinkyProject/slurm-client/api/v0043"
 	apierrors "github.com/SlinkyProject/slurm-client/pkg/errors"
 	"github.com/SlinkyProject/slurm-client/pkg/types"
 	"github.com/SlinkyProject/slurm-client/pkg/utils"
@@ -43,11 +43,11 @@ func (c *SlurmClient) ListControllerPing(ctx context.Context) (*types.V0043Contr
 	if err != nil {
 		return nil, err
 	} else if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0043OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return nil, errors.Join(errs...)
+		return nil, newSlurmError(res.StatusCode(), errs)
 	}
 	list := &types.V0043ControllerPingList{
 		Items: make([]types.V0043ControllerPing, len(res.JSON200.Pings)),
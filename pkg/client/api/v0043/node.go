@@ -31,11 +31,11 @@ func (c *SlurmClient) DeleteNode(ctx context.Context, nodeName string) error {
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0043OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return errors.Join(errs...)
+		return newSlurmError(res.StatusCode(), errs)
 	}
 
 	return nil
@@ -54,11 +54,11 @@ func (c *SlurmClient) UpdateNode(ctx context.Context, nodeName string, req any)
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0043OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return errors.Join(errs...)
+		return newSlurmError(res.StatusCode(), errs)
 	}
 
 	return nil
@@ -73,11 +73,11 @@ func (c *SlurmClient) GetNode(ctx context.Context, nodeName string) (*types.V004
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0043OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return nil, errors.Join(errs...)
+		return nil, newSlurmError(res.StatusCode(), errs)
 	}
 
 	if len(res.JSON200.Nodes) == 0 {
@@ -98,11 +98,11 @@ func (c *SlurmClient) ListNodes(ctx context.Context) (*types.V0043NodeList, erro
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0043OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return nil, errors.Join(errs...)
+		return nil, newSlurmError(res.StatusCode(), errs)
 	}
 
 	list := &types.V0043NodeList{
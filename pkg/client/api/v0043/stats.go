@@ -5,9 +5,9 @@ package v0043
 
 import (
 	"context"
-	"errors"
 	"net/http"
 
+	api "github.com/SlinkyProject/slurm-client/api/v0043"
 	"github.com/SlinkyProject/slurm-client/pkg/types"
 	"github.com/SlinkyProject/slurm-client/pkg/utils"
 )
@@ -25,11 +25,11 @@ func (c *SlurmClient) GetStats(ctx context.Context) (*types.V0043Stats, error) {
 	if err != nil {
 		return nil, err
 	} else if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0043OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return nil, errors.Join(errs...)
+		return nil, newSlurmError(res.StatusCode(), errs)
 	}
 	out := &types.V0043Stats{}
 	utils.RemarshalOrDie(res.JSON200.Statistics, out)
@@ -5,7 +5,6 @@ package v0043
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 
@@ -13,6 +12,7 @@ import (
 
 	api "github.com/SlinkyProject/slurm-client/api/v0043"
 	clientapi "github.com/SlinkyProject/slurm-client/pkg/client/api"
+	apierrors "github.com/SlinkyProject/slurm-client/pkg/errors"
 )
 
 const (
@@ -27,6 +27,7 @@ type ClientInterface interface {
 	api.ClientWithResponsesInterface
 	ControllerPingInfoInterface
 	JobInfoInterface
+	LicenseInterface
 	NodeInterface
 	PartitionInterface
 	ReconfigureInterface
@@ -77,12 +78,20 @@ func NewSlurmClient(server, token string, httpServer *http.Client, opts ...clien
 	return &SlurmClient{client}, nil
 }
 
-func getOpenapiErrors(oapierrors *api.V0043OpenapiErrors) []error {
-	errs := []error{}
+// newSlurmError returns a SlurmError for a response with statusCode and the
+// errors reported by Slurm.
+func newSlurmError(statusCode int, oapierrors *api.V0043OpenapiErrors) error {
+	errs := []apierrors.SlurmErrorEntry{}
 	for _, err := range ptr.Deref(oapierrors, []api.V0043OpenapiError{}) {
-		if err.Error != nil {
-			errs = append(errs, errors.New(*err.Error))
-		}
+		errs = append(errs, apierrors.SlurmErrorEntry{
+			ErrorNumber: apierrors.Errno(ptr.Deref(err.ErrorNumber, 0)),
+			Error:       ptr.Deref(err.Error, ""),
+			Description: ptr.Deref(err.Description, ""),
+			Source:      ptr.Deref(err.Source, ""),
+		})
+	}
+	return &apierrors.SlurmError{
+		StatusCode: statusCode,
+		Errors:     errs,
 	}
-	return errs
 }
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package v0044
+
+import (
+	"context"
+	"net/http"
+
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0044"
+	apierrors "github.com/SlinkyProject/slurm-client/pkg/errors"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+type LicenseInterface interface {
+	GetLicense(ctx context.Context, name string) (*types.V0044License, error)
+	ListLicenses(ctx context.Context) (*types.V0044LicenseList, error)
+}
+
+var _ LicenseInterface = &SlurmClient{}
+
+// GetLicense implements ClientInterface
+func (c *SlurmClient) GetLicense(ctx context.Context, name string) (*types.V0044License, error) {
+	list, err := c.ListLicenses(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range list.Items {
+		licenseName := ptr.Deref(item.LicenseName, "")
+		if licenseName == name {
+			return &item, nil
+		}
+	}
+	return nil, apierrors.ErrObjectNotFound
+}
+
+// ListLicenses implements ClientInterface
+func (c *SlurmClient) ListLicenses(ctx context.Context) (*types.V0044LicenseList, error) {
+	res, err := c.SlurmV0044GetLicensesWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	} else if res.StatusCode() != http.StatusOK {
+		var errs *api.V0044OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return nil, newSlurmError(res.StatusCode(), errs)
+	}
+	list := &types.V0044LicenseList{
+		Items: make([]types.V0044License, len(res.JSON200.Licenses)),
+	}
+	for i, item := range res.JSON200.Licenses {
+		utils.RemarshalOrDie(item, &list.Items[i])
+	}
+	return list, nil
+}
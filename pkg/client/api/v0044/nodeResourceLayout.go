@@ -5,7 +5,6 @@ package v0044
 
 import (
 	"context"
-	"errors"
 	"net/http"
 
 	api "github.com/SlinkyProject/slurm-client/api/v0044"
@@ -28,11 +27,11 @@ func (c *SlurmClient) GetNodeResourceLayout(ctx context.Context, jobId string) (
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0044OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return nil, errors.Join(errs...)
+		return nil, newSlurmError(res.StatusCode(), errs)
 	}
 
 	if len(res.JSON200.Nodes) == 0 {
@@ -5,9 +5,9 @@ package v0044
 
 import (
 	"context"
-	"errors"
 	"net/http"
 
+	api "github.com/SlinkyProject/slurm-client/api/v0044"
 	"github.com/SlinkyProject/slurm-client/pkg/types"
 )
 
@@ -25,11 +25,11 @@ func (c *SlurmClient) GetReconfigure(ctx context.Context) (*types.V0044Reconfigu
 		return nil, err
 	}
 	if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0044OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return nil, errors.Join(errs...)
+		return nil, newSlurmError(res.StatusCode(), errs)
 	}
 	out := &types.V0044Reconfigure{}
 	return out, nil
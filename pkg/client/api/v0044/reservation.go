@@ -8,7 +8,10 @@ import (
 	"errors"
 	"net/http"
 
+	"k8s.io/utils/ptr"
+
 	api "github.com/SlinkyProject/slurm-client/api/v0044"
+	clientapi "github.com/SlinkyProject/slurm-client/pkg/client/api"
 	apierrors "github.com/SlinkyProject/slurm-client/pkg/errors"
 	"github.com/SlinkyProject/slurm-client/pkg/types"
 	"github.com/SlinkyProject/slurm-client/pkg/utils"
@@ -17,6 +20,7 @@ import (
 type ReservationInterface interface {
 	CreateReservationInfo(ctx context.Context, req any) (string, error)
 	UpdateReservationInfo(ctx context.Context, name string, req any) error
+	CreateOrUpdateReservationInfos(ctx context.Context, req any) ([]clientapi.ReservationResult, error)
 	DeleteReservationInfo(ctx context.Context, name string) error
 	GetReservationInfo(ctx context.Context, name string) (*types.V0044ReservationInfo, error)
 	ListReservationInfo(ctx context.Context) (*types.V0044ReservationInfoList, error)
@@ -38,11 +42,11 @@ func (c *SlurmClient) CreateReservationInfo(ctx context.Context, req any) (strin
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0044OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return "", errors.Join(errs...)
+		return "", newSlurmError(res.StatusCode(), errs)
 	}
 
 	return *r.Name, nil
@@ -56,11 +60,11 @@ func (c *SlurmClient) DeleteReservationInfo(ctx context.Context, name string) er
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0044OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return errors.Join(errs...)
+		return newSlurmError(res.StatusCode(), errs)
 	}
 
 	return nil
@@ -83,16 +87,62 @@ func (c *SlurmClient) UpdateReservationInfo(ctx context.Context, name string, re
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0044OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return errors.Join(errs...)
+		return newSlurmError(res.StatusCode(), errs)
 	}
 
 	return nil
 }
 
+// CreateOrUpdateReservationInfos implements ClientInterface
+func (c *SlurmClient) CreateOrUpdateReservationInfos(ctx context.Context, req any) ([]clientapi.ReservationResult, error) {
+	r, ok := req.(api.V0044ReservationModReq)
+	if !ok {
+		return nil, errors.New("expected req to be V0044ReservationModReq")
+	}
+
+	body := api.SlurmV0044PostReservationsJSONRequestBody(r)
+	res, err := c.SlurmV0044PostReservationsWithResponse(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var resErr error
+	var applied api.V0044ReservationDescMsgList
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0044OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+			applied = res.JSONDefault.Reservations
+		}
+		resErr = newSlurmError(res.StatusCode(), errs)
+	} else {
+		applied = res.JSON200.Reservations
+	}
+
+	names := make(map[string]bool, len(applied))
+	for _, item := range applied {
+		names[ptr.Deref(item.Name, "")] = true
+	}
+
+	// Reservations are applied together, only those the server did not
+	// report back take on the request error.
+	results := []clientapi.ReservationResult{}
+	for _, item := range ptr.Deref(r.Reservations, nil) {
+		result := clientapi.ReservationResult{
+			Name: ptr.Deref(item.Name, ""),
+		}
+		if resErr != nil && !names[result.Name] {
+			result.Error = resErr
+		}
+		results = append(results, result)
+	}
+	return results, resErr
+}
+
 // GetReservationInfo implements ClientInterface
 func (c *SlurmClient) GetReservationInfo(ctx context.Context, name string) (*types.V0044ReservationInfo, error) {
 	params := &api.SlurmV0044GetReservationParams{}
@@ -102,11 +152,11 @@ func (c *SlurmClient) GetReservationInfo(ctx context.Context, name string) (*typ
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0044OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return nil, errors.Join(errs...)
+		return nil, newSlurmError(res.StatusCode(), errs)
 	}
 
 	if len(res.JSON200.Reservations) == 0 {
@@ -127,11 +177,11 @@ func (c *SlurmClient) ListReservationInfo(ctx context.Context) (*types.V0044Rese
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0044OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return nil, errors.Join(errs...)
+		return nil, newSlurmError(res.StatusCode(), errs)
 	}
 
 	list := &types.V0044ReservationInfoList{
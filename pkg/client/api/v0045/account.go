@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package v0045
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	apierrors "github.com/SlinkyProject/slurm-client/pkg/errors"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+type AccountInterface interface {
+	CreateAccount(ctx context.Context, req any) (string, error)
+	DeleteAccount(ctx context.Context, name string) error
+	UpdateAccount(ctx context.Context, name string, req any) error
+	GetAccount(ctx context.Context, name string, params any) (*types.V0045Account, error)
+	ListAccounts(ctx context.Context, params any) (*types.V0045AccountList, error)
+}
+
+var _ AccountInterface = &SlurmClient{}
+
+// CreateAccount implements ClientInterface
+func (c *SlurmClient) CreateAccount(ctx context.Context, req any) (string, error) {
+	r, ok := req.(api.V0045Account)
+	if !ok {
+		return "", errors.New("expected req to be V0045Account")
+	}
+
+	if err := c.postAccounts(ctx, r); err != nil {
+		return "", err
+	}
+
+	return r.Name, nil
+}
+
+// DeleteAccount implements ClientInterface
+func (c *SlurmClient) DeleteAccount(ctx context.Context, name string) error {
+	res, err := c.SlurmdbV0045DeleteAccountWithResponse(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return newSlurmError(res.StatusCode(), errs)
+	}
+
+	if len(res.JSON200.RemovedAccounts) == 0 {
+		return apierrors.ErrObjectNotFound
+	}
+
+	return nil
+}
+
+// UpdateAccount implements ClientInterface
+func (c *SlurmClient) UpdateAccount(ctx context.Context, name string, req any) error {
+	r, ok := req.(api.V0045Account)
+	if !ok {
+		return errors.New("expected req to be V0045Account")
+	}
+
+	// endpoint does not use ID parameter, but make it uniform with the rest that do
+	r.Name = name
+
+	return c.postAccounts(ctx, r)
+}
+
+func (c *SlurmClient) postAccounts(ctx context.Context, account api.V0045Account) error {
+	body := api.SlurmdbV0045PostAccountsJSONRequestBody{
+		Accounts: api.V0045AccountList{account},
+	}
+	res, err := c.SlurmdbV0045PostAccountsWithResponse(ctx, body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return newSlurmError(res.StatusCode(), errs)
+	}
+
+	return nil
+}
+
+// GetAccount implements ClientInterface
+func (c *SlurmClient) GetAccount(ctx context.Context, name string, params any) (*types.V0045Account, error) {
+	p := &api.SlurmdbV0045GetAccountParams{}
+	switch r := params.(type) {
+	case nil:
+	case api.SlurmdbV0045GetAccountParams:
+		p = &r
+	case *api.SlurmdbV0045GetAccountParams:
+		p = r
+	default:
+		return nil, errors.New("expected params to be SlurmdbV0045GetAccountParams")
+	}
+
+	res, err := c.SlurmdbV0045GetAccountWithResponse(ctx, name, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return nil, newSlurmError(res.StatusCode(), errs)
+	}
+
+	if len(res.JSON200.Accounts) == 0 {
+		return nil, apierrors.ErrObjectNotFound
+	}
+
+	out := &types.V0045Account{}
+	utils.RemarshalOrDie(res.JSON200.Accounts[0], out)
+	return out, nil
+}
+
+// ListAccounts implements ClientInterface
+func (c *SlurmClient) ListAccounts(ctx context.Context, params any) (*types.V0045AccountList, error) {
+	p := &api.SlurmdbV0045GetAccountsParams{}
+	switch r := params.(type) {
+	case nil:
+	case api.SlurmdbV0045GetAccountsParams:
+		p = &r
+	case *api.SlurmdbV0045GetAccountsParams:
+		p = r
+	default:
+		return nil, errors.New("expected params to be SlurmdbV0045GetAccountsParams")
+	}
+
+	res, err := c.SlurmdbV0045GetAccountsWithResponse(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return nil, newSlurmError(res.StatusCode(), errs)
+	}
+
+	list := &types.V0045AccountList{
+		Items: make([]types.V0045Account, len(res.JSON200.Accounts)),
+	}
+	for i, item := range res.JSON200.Accounts {
+		utils.RemarshalOrDie(item, &list.Items[i])
+	}
+	return list, nil
+}
@@ -0,0 +1,660 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package v0045
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/client/api/v0045/fake"
+	"github.com/SlinkyProject/slurm-client/pkg/client/api/v0045/interceptor"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+)
+
+func TestSlurmClient_CreateAccount(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx context.Context
+		req any
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045PostAccountsWithResponse: func(ctx context.Context, body api.V0045OpenapiAccountsResp, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045PostAccountsResponse, error) {
+							if len(body.Accounts) != 1 || body.Accounts[0].Name != "account-0" {
+								return nil, errors.New("unexpected request body")
+							}
+							res := &api.SlurmdbV0045PostAccountsResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200:      &api.V0045OpenapiResp{},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+				req: api.V0045Account{Name: "account-0"},
+			},
+			want:    "account-0",
+			wantErr: false,
+		},
+		{
+			name: "Bad request",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx: context.Background(),
+				req: nil,
+			},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045PostAccountsWithResponse: func(ctx context.Context, body api.V0045OpenapiAccountsResp, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045PostAccountsResponse, error) {
+							res := &api.SlurmdbV0045PostAccountsResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0045OpenapiResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+				req: api.V0045Account{Name: "account-0"},
+			},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name: "HTTP Error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045PostAccountsWithResponse: func(ctx context.Context, body api.V0045OpenapiAccountsResp, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045PostAccountsResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+				req: api.V0045Account{Name: "account-0"},
+			},
+			want:    "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			got, err := c.CreateAccount(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.CreateAccount() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSlurmClient_DeleteAccount(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx  context.Context
+		name string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045DeleteAccountWithResponse: func(ctx context.Context, accountName string, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045DeleteAccountResponse, error) {
+							res := &api.SlurmdbV0045DeleteAccountResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiAccountsRemovedResp{
+									RemovedAccounts: api.V0045StringList{accountName},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "account-0",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Not Found",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "account-0",
+			},
+			wantErr: true,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045DeleteAccountWithResponse: func(ctx context.Context, accountName string, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045DeleteAccountResponse, error) {
+							res := &api.SlurmdbV0045DeleteAccountResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0045OpenapiAccountsRemovedResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "account-0",
+			},
+			wantErr: true,
+		},
+		{
+			name: "HTTP Error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045DeleteAccountWithResponse: func(ctx context.Context, accountName string, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045DeleteAccountResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "account-0",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			if err := c.DeleteAccount(tt.args.ctx, tt.args.name); (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.DeleteAccount() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSlurmClient_UpdateAccount(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx  context.Context
+		name string
+		req  any
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045PostAccountsWithResponse: func(ctx context.Context, body api.V0045OpenapiAccountsResp, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045PostAccountsResponse, error) {
+							if len(body.Accounts) != 1 || body.Accounts[0].Name != "account-0" {
+								return nil, errors.New("unexpected request body")
+							}
+							res := &api.SlurmdbV0045PostAccountsResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200:      &api.V0045OpenapiResp{},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "account-0",
+				req:  api.V0045Account{Description: "foo"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Bad request",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "account-0",
+				req:  nil,
+			},
+			wantErr: true,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045PostAccountsWithResponse: func(ctx context.Context, body api.V0045OpenapiAccountsResp, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045PostAccountsResponse, error) {
+							res := &api.SlurmdbV0045PostAccountsResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0045OpenapiResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "account-0",
+				req:  api.V0045Account{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "HTTP Error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045PostAccountsWithResponse: func(ctx context.Context, body api.V0045OpenapiAccountsResp, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045PostAccountsResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "account-0",
+				req:  api.V0045Account{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			if err := c.UpdateAccount(tt.args.ctx, tt.args.name, tt.args.req); (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.UpdateAccount() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSlurmClient_GetAccount(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx    context.Context
+		name   string
+		params any
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *types.V0045Account
+		wantErr bool
+	}{
+		{
+			name: "Not Found",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "account-0",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Found",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetAccountWithResponse: func(ctx context.Context, accountName string, params *api.SlurmdbV0045GetAccountParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetAccountResponse, error) {
+							res := &api.SlurmdbV0045GetAccountResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiAccountsResp{
+									Accounts: api.V0045AccountList{
+										{Name: accountName},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "account-0",
+			},
+			want: &types.V0045Account{
+				V0045Account: api.V0045Account{Name: "account-0"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Found with params",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetAccountWithResponse: func(ctx context.Context, accountName string, params *api.SlurmdbV0045GetAccountParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetAccountResponse, error) {
+							if params.WithCoords == nil {
+								return nil, errors.New("expected with_coords param")
+							}
+							res := &api.SlurmdbV0045GetAccountResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiAccountsResp{
+									Accounts: api.V0045AccountList{
+										{
+											Name:         accountName,
+											Coordinators: &api.V0045CoordList{{Name: "user-0"}},
+										},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				name:   "account-0",
+				params: &api.SlurmdbV0045GetAccountParams{WithCoords: ptr.To("true")},
+			},
+			want: &types.V0045Account{
+				V0045Account: api.V0045Account{
+					Name:         "account-0",
+					Coordinators: &api.V0045CoordList{{Name: "user-0"}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Bad params",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				name:   "account-0",
+				params: api.SlurmdbV0045GetAccountsParams{},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetAccountWithResponse: func(ctx context.Context, accountName string, params *api.SlurmdbV0045GetAccountParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetAccountResponse, error) {
+							res := &api.SlurmdbV0045GetAccountResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0045OpenapiAccountsResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "account-0",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP Error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetAccountWithResponse: func(ctx context.Context, accountName string, params *api.SlurmdbV0045GetAccountParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetAccountResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "account-0",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			got, err := c.GetAccount(tt.args.ctx, tt.args.name, tt.args.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.GetAccount() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSlurmClient_ListAccounts(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx    context.Context
+		params any
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *types.V0045AccountList
+		wantErr bool
+	}{
+		{
+			name: "Empty",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: &types.V0045AccountList{
+				Items: make([]types.V0045Account, 0),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Not Empty",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetAccountsWithResponse: func(ctx context.Context, params *api.SlurmdbV0045GetAccountsParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetAccountsResponse, error) {
+							res := &api.SlurmdbV0045GetAccountsResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiAccountsResp{
+									Accounts: api.V0045AccountList{
+										{Name: "account-0"},
+										{Name: "account-1"},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: api.SlurmdbV0045GetAccountsParams{WithAssociations: ptr.To("true")},
+			},
+			want: &types.V0045AccountList{
+				Items: []types.V0045Account{
+					{V0045Account: api.V0045Account{Name: "account-0"}},
+					{V0045Account: api.V0045Account{Name: "account-1"}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Bad params",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: api.SlurmdbV0045GetAccountParams{},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetAccountsWithResponse: func(ctx context.Context, params *api.SlurmdbV0045GetAccountsParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetAccountsResponse, error) {
+							res := &api.SlurmdbV0045GetAccountsResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0045OpenapiAccountsResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP Error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetAccountsWithResponse: func(ctx context.Context, params *api.SlurmdbV0045GetAccountsParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetAccountsResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			got, err := c.ListAccounts(tt.args.ctx, tt.args.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.ListAccounts() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
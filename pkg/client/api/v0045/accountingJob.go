@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package v0045
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	apierrors "github.com/SlinkyProject/slurm-client/pkg/errors"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+type AccountingJobInterface interface {
+	GetAccountingJob(ctx context.Context, jobId string) (*types.V0045AccountingJob, error)
+	ListAccountingJobs(ctx context.Context, params any) (*types.V0045AccountingJobList, error)
+}
+
+var _ AccountingJobInterface = &SlurmClient{}
+
+// GetAccountingJob implements ClientInterface
+func (c *SlurmClient) GetAccountingJob(ctx context.Context, jobId string) (*types.V0045AccountingJob, error) {
+	res, err := c.SlurmdbV0045GetJobWithResponse(ctx, jobId)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return nil, newSlurmError(res.StatusCode(), errs)
+	}
+
+	if len(res.JSON200.Jobs) == 0 {
+		return nil, apierrors.ErrObjectNotFound
+	}
+
+	out := &types.V0045AccountingJob{}
+	utils.RemarshalOrDie(res.JSON200.Jobs[0], out)
+	return out, nil
+}
+
+// ListAccountingJobs implements ClientInterface
+func (c *SlurmClient) ListAccountingJobs(ctx context.Context, params any) (*types.V0045AccountingJobList, error) {
+	p := &api.SlurmdbV0045GetJobsParams{}
+	switch r := params.(type) {
+	case nil:
+	case api.SlurmdbV0045GetJobsParams:
+		p = &r
+	case *api.SlurmdbV0045GetJobsParams:
+		p = r
+	case *types.V0045AccountingJobQuery:
+		p = r.Params()
+	default:
+		return nil, errors.New("expected params to be SlurmdbV0045GetJobsParams or V0045AccountingJobQuery")
+	}
+
+	res, err := c.SlurmdbV0045GetJobsWithResponse(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return nil, newSlurmError(res.StatusCode(), errs)
+	}
+
+	list := &types.V0045AccountingJobList{
+		Items: make([]types.V0045AccountingJob, len(res.JSON200.Jobs)),
+	}
+	for i, item := range res.JSON200.Jobs {
+		utils.RemarshalOrDie(item, &list.Items[i])
+	}
+	return list, nil
+}
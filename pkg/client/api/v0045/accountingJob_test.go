@@ -0,0 +1,307 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package v0045
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/client/api/v0045/fake"
+	"github.com/SlinkyProject/slurm-client/pkg/client/api/v0045/interceptor"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+)
+
+func TestSlurmClient_GetAccountingJob(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx   context.Context
+		jobId string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *types.V0045AccountingJob
+		wantErr bool
+	}{
+		{
+			name: "Not Found",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				jobId: "1",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Found",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetJobWithResponse: func(ctx context.Context, jobId string, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetJobResponse, error) {
+							res := &api.SlurmdbV0045GetJobResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiSlurmdbdJobsResp{
+									Jobs: api.V0045JobList{
+										{JobId: ptr.To[int32](1)},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				jobId: "1",
+			},
+			want: &types.V0045AccountingJob{
+				V0045Job: api.V0045Job{JobId: ptr.To[int32](1)},
+			},
+			wantErr: false,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetJobWithResponse: func(ctx context.Context, jobId string, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetJobResponse, error) {
+							res := &api.SlurmdbV0045GetJobResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0045OpenapiSlurmdbdJobsResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				jobId: "1",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP Error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetJobWithResponse: func(ctx context.Context, jobId string, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetJobResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				jobId: "1",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			got, err := c.GetAccountingJob(tt.args.ctx, tt.args.jobId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.GetAccountingJob() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSlurmClient_ListAccountingJobs(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx    context.Context
+		params any
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *types.V0045AccountingJobList
+		wantErr bool
+	}{
+		{
+			name: "Empty",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: &types.V0045AccountingJobList{
+				Items: make([]types.V0045AccountingJob, 0),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Not Empty",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetJobsWithResponse: func(ctx context.Context, params *api.SlurmdbV0045GetJobsParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetJobsResponse, error) {
+							res := &api.SlurmdbV0045GetJobsResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiSlurmdbdJobsResp{
+									Jobs: api.V0045JobList{
+										{JobId: ptr.To[int32](1)},
+										{JobId: ptr.To[int32](2)},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: api.SlurmdbV0045GetJobsParams{Users: ptr.To("alice")},
+			},
+			want: &types.V0045AccountingJobList{
+				Items: []types.V0045AccountingJob{
+					{V0045Job: api.V0045Job{JobId: ptr.To[int32](1)}},
+					{V0045Job: api.V0045Job{JobId: ptr.To[int32](2)}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Query",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetJobsWithResponse: func(ctx context.Context, params *api.SlurmdbV0045GetJobsParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetJobsResponse, error) {
+							if ptr.Deref(params.Users, "") != "alice" {
+								return nil, errors.New("unexpected params")
+							}
+							res := &api.SlurmdbV0045GetJobsResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiSlurmdbdJobsResp{
+									Jobs: api.V0045JobList{
+										{JobId: ptr.To[int32](1)},
+										{JobId: ptr.To[int32](2)},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: types.NewV0045AccountingJobQuery().WithUsers("alice"),
+			},
+			want: &types.V0045AccountingJobList{
+				Items: []types.V0045AccountingJob{
+					{V0045Job: api.V0045Job{JobId: ptr.To[int32](1)}},
+					{V0045Job: api.V0045Job{JobId: ptr.To[int32](2)}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Bad params",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: api.SlurmdbV0045GetQosParams{},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetJobsWithResponse: func(ctx context.Context, params *api.SlurmdbV0045GetJobsParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetJobsResponse, error) {
+							res := &api.SlurmdbV0045GetJobsResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0045OpenapiSlurmdbdJobsResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP Error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetJobsWithResponse: func(ctx context.Context, params *api.SlurmdbV0045GetJobsParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetJobsResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			got, err := c.ListAccountingJobs(tt.args.ctx, tt.args.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.ListAccountingJobs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,245 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package v0045
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	apierrors "github.com/SlinkyProject/slurm-client/pkg/errors"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+type AssociationInterface interface {
+	CreateAssociation(ctx context.Context, req any) (string, error)
+	DeleteAssociation(ctx context.Context, key string) error
+	DeleteAssociations(ctx context.Context, params any) ([]string, error)
+	UpdateAssociation(ctx context.Context, key string, req any) error
+	GetAssociation(ctx context.Context, key string, params any) (*types.V0045Association, error)
+	ListAssociations(ctx context.Context, params any) (*types.V0045AssociationList, error)
+}
+
+var _ AssociationInterface = &SlurmClient{}
+
+// CreateAssociation implements ClientInterface
+func (c *SlurmClient) CreateAssociation(ctx context.Context, req any) (string, error) {
+	r, ok := req.(api.V0045Assoc)
+	if !ok {
+		return "", errors.New("expected req to be V0045Assoc")
+	}
+
+	if err := c.postAssociations(ctx, r); err != nil {
+		return "", err
+	}
+
+	assoc := &types.V0045Association{V0045Assoc: r}
+	return string(assoc.GetKey()), nil
+}
+
+// DeleteAssociation implements ClientInterface
+func (c *SlurmClient) DeleteAssociation(ctx context.Context, key string) error {
+	assocKey, err := types.ParseAssociationKey(object.ObjectKey(key))
+	if err != nil {
+		return err
+	}
+
+	// Empty filters match everything, so resolve the exact association and
+	// delete it by id.
+	assoc, err := c.GetAssociation(ctx, key, nil)
+	if err != nil {
+		return err
+	}
+	if assoc.Id == nil {
+		return fmt.Errorf("association %q has no id", key)
+	}
+
+	params := &api.SlurmdbV0045DeleteAssociationParams{
+		Id:        ptr.To(strconv.Itoa(int(*assoc.Id))),
+		Cluster:   utils.StringPtrOrNil(assocKey.Cluster),
+		Account:   utils.StringPtrOrNil(assocKey.Account),
+		User:      utils.StringPtrOrNil(assocKey.User),
+		Partition: utils.StringPtrOrNil(assocKey.Partition),
+	}
+	res, err := c.SlurmdbV0045DeleteAssociationWithResponse(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return newSlurmError(res.StatusCode(), errs)
+	}
+
+	switch removed := res.JSON200.RemovedAssociations; len(removed) {
+	case 0:
+		return apierrors.ErrObjectNotFound
+	case 1:
+		return nil
+	default:
+		return fmt.Errorf("deleting association %q removed %d associations: %v", key, len(removed), removed)
+	}
+}
+
+// DeleteAssociations implements ClientInterface
+func (c *SlurmClient) DeleteAssociations(ctx context.Context, params any) ([]string, error) {
+	var p *api.SlurmdbV0045DeleteAssociationsParams
+	switch r := params.(type) {
+	case api.SlurmdbV0045DeleteAssociationsParams:
+		p = &r
+	case *api.SlurmdbV0045DeleteAssociationsParams:
+		p = r
+	default:
+		return nil, errors.New("expected params to be SlurmdbV0045DeleteAssociationsParams")
+	}
+
+	res, err := c.SlurmdbV0045DeleteAssociationsWithResponse(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return nil, newSlurmError(res.StatusCode(), errs)
+	}
+
+	return res.JSON200.RemovedAssociations, nil
+}
+
+// UpdateAssociation implements ClientInterface
+func (c *SlurmClient) UpdateAssociation(ctx context.Context, key string, req any) error {
+	r, ok := req.(api.V0045Assoc)
+	if !ok {
+		return errors.New("expected req to be V0045Assoc")
+	}
+
+	assocKey, err := types.ParseAssociationKey(object.ObjectKey(key))
+	if err != nil {
+		return err
+	}
+
+	// endpoint does not use ID parameters, but make it uniform with the rest that do
+	r.Cluster = ptr.To(assocKey.Cluster)
+	r.Account = ptr.To(assocKey.Account)
+	r.User = assocKey.User
+	r.Partition = ptr.To(assocKey.Partition)
+
+	return c.postAssociations(ctx, r)
+}
+
+func (c *SlurmClient) postAssociations(ctx context.Context, assoc api.V0045Assoc) error {
+	body := api.SlurmdbV0045PostAssociationsJSONRequestBody{
+		Associations: api.V0045AssocList{assoc},
+	}
+	res, err := c.SlurmdbV0045PostAssociationsWithResponse(ctx, body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return newSlurmError(res.StatusCode(), errs)
+	}
+
+	return nil
+}
+
+// GetAssociation implements ClientInterface
+func (c *SlurmClient) GetAssociation(ctx context.Context, key string, params any) (*types.V0045Association, error) {
+	p := &api.SlurmdbV0045GetAssociationParams{}
+	switch r := params.(type) {
+	case nil:
+	case api.SlurmdbV0045GetAssociationParams:
+		p = &r
+	case *api.SlurmdbV0045GetAssociationParams:
+		clone := *r
+		p = &clone
+	default:
+		return nil, errors.New("expected params to be SlurmdbV0045GetAssociationParams")
+	}
+
+	assocKey, err := types.ParseAssociationKey(object.ObjectKey(key))
+	if err != nil {
+		return nil, err
+	}
+	p.Cluster = ptr.To(assocKey.Cluster)
+	p.Account = ptr.To(assocKey.Account)
+	p.User = ptr.To(assocKey.User)
+	p.Partition = ptr.To(assocKey.Partition)
+
+	res, err := c.SlurmdbV0045GetAssociationWithResponse(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return nil, newSlurmError(res.StatusCode(), errs)
+	}
+
+	// Empty filters match everything, so only take the exact association.
+	for _, item := range res.JSON200.Associations {
+		out := &types.V0045Association{}
+		utils.RemarshalOrDie(item, out)
+		if out.GetAssociationKey() == assocKey {
+			return out, nil
+		}
+	}
+
+	return nil, apierrors.ErrObjectNotFound
+}
+
+// ListAssociations implements ClientInterface
+func (c *SlurmClient) ListAssociations(ctx context.Context, params any) (*types.V0045AssociationList, error) {
+	p := &api.SlurmdbV0045GetAssociationsParams{}
+	switch r := params.(type) {
+	case nil:
+	case api.SlurmdbV0045GetAssociationsParams:
+		p = &r
+	case *api.SlurmdbV0045GetAssociationsParams:
+		p = r
+	default:
+		return nil, errors.New("expected params to be SlurmdbV0045GetAssociationsParams")
+	}
+
+	res, err := c.SlurmdbV0045GetAssociationsWithResponse(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return nil, newSlurmError(res.StatusCode(), errs)
+	}
+
+	list := &types.V0045AssociationList{
+		Items: make([]types.V0045Association, len(res.JSON200.Associations)),
+	}
+	for i, item := range res.JSON200.Associations {
+		utils.RemarshalOrDie(item, &list.Items[i])
+	}
+	return list, nil
+}
@@ -0,0 +1,837 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package v0045
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/client/api/v0045/fake"
+	"github.com/SlinkyProject/slurm-client/pkg/client/api/v0045/interceptor"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+)
+
+const testAssociationKey = "linux/account-0/user-0/"
+
+var testAssociation = api.V0045Assoc{
+	Cluster: ptr.To("linux"),
+	Account: ptr.To("account-0"),
+	User:    "user-0",
+}
+
+func TestSlurmClient_CreateAssociation(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx context.Context
+		req any
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx: context.Background(),
+				req: testAssociation,
+			},
+			want:    testAssociationKey,
+			wantErr: false,
+		},
+		{
+			name: "Bad request",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx: context.Background(),
+				req: nil,
+			},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045PostAssociationsWithResponse: func(ctx context.Context, body api.V0045OpenapiAssocsResp, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045PostAssociationsResponse, error) {
+							res := &api.SlurmdbV0045PostAssociationsResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0045OpenapiResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+				req: testAssociation,
+			},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name: "HTTP Error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045PostAssociationsWithResponse: func(ctx context.Context, body api.V0045OpenapiAssocsResp, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045PostAssociationsResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+				req: testAssociation,
+			},
+			want:    "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			got, err := c.CreateAssociation(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.CreateAssociation() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSlurmClient_DeleteAssociation(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx context.Context
+		key string
+	}
+	// An account-level association next to user associations of the account.
+	getAssociation := func(ctx context.Context, params *api.SlurmdbV0045GetAssociationParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetAssociationResponse, error) {
+		res := &api.SlurmdbV0045GetAssociationResponse{
+			HTTPResponse: &fake.HttpSuccess,
+			JSON200: &api.V0045OpenapiAssocsResp{
+				Associations: api.V0045AssocList{
+					{Id: ptr.To[int32](1), Cluster: ptr.To("linux"), Account: ptr.To("account-0")},
+					{Id: ptr.To[int32](2), Cluster: ptr.To("linux"), Account: ptr.To("account-0"), User: "user-0"},
+					{Id: ptr.To[int32](3), Cluster: ptr.To("linux"), Account: ptr.To("account-0"), User: "user-1"},
+				},
+			},
+		}
+		return res, nil
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetAssociationWithResponse: getAssociation,
+						SlurmdbV0045DeleteAssociationWithResponse: func(ctx context.Context, params *api.SlurmdbV0045DeleteAssociationParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045DeleteAssociationResponse, error) {
+							if ptr.Deref(params.Id, "") != "2" || ptr.Deref(params.User, "") != "user-0" || params.Partition != nil {
+								return nil, errors.New("unexpected params")
+							}
+							res := &api.SlurmdbV0045DeleteAssociationResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiAssocsRemovedResp{
+									RemovedAssociations: api.V0045StringList{"foo"},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+				key: testAssociationKey,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Account-level association",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetAssociationWithResponse: getAssociation,
+						SlurmdbV0045DeleteAssociationWithResponse: func(ctx context.Context, params *api.SlurmdbV0045DeleteAssociationParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045DeleteAssociationResponse, error) {
+							if ptr.Deref(params.Id, "") != "1" || params.User != nil || params.Partition != nil {
+								return nil, errors.New("unexpected params")
+							}
+							res := &api.SlurmdbV0045DeleteAssociationResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiAssocsRemovedResp{
+									RemovedAssociations: api.V0045StringList{"foo"},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+				key: "linux/account-0//",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Removed other associations",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetAssociationWithResponse: getAssociation,
+						SlurmdbV0045DeleteAssociationWithResponse: func(ctx context.Context, params *api.SlurmdbV0045DeleteAssociationParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045DeleteAssociationResponse, error) {
+							res := &api.SlurmdbV0045DeleteAssociationResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiAssocsRemovedResp{
+									RemovedAssociations: api.V0045StringList{"foo", "bar", "baz"},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+				key: "linux/account-0//",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Not Found",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx: context.Background(),
+				key: testAssociationKey,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Bad key",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx: context.Background(),
+				key: "foo",
+			},
+			wantErr: true,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetAssociationWithResponse: getAssociation,
+						SlurmdbV0045DeleteAssociationWithResponse: func(ctx context.Context, params *api.SlurmdbV0045DeleteAssociationParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045DeleteAssociationResponse, error) {
+							res := &api.SlurmdbV0045DeleteAssociationResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0045OpenapiAssocsRemovedResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+				key: testAssociationKey,
+			},
+			wantErr: true,
+		},
+		{
+			name: "HTTP Error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetAssociationWithResponse: getAssociation,
+						SlurmdbV0045DeleteAssociationWithResponse: func(ctx context.Context, params *api.SlurmdbV0045DeleteAssociationParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045DeleteAssociationResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+				key: testAssociationKey,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			if err := c.DeleteAssociation(tt.args.ctx, tt.args.key); (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.DeleteAssociation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSlurmClient_DeleteAssociations(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx    context.Context
+		params any
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045DeleteAssociationsWithResponse: func(ctx context.Context, params *api.SlurmdbV0045DeleteAssociationsParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045DeleteAssociationsResponse, error) {
+							res := &api.SlurmdbV0045DeleteAssociationsResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiAssocsRemovedResp{
+									RemovedAssociations: api.V0045StringList{"foo", "bar"},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: api.SlurmdbV0045DeleteAssociationsParams{Account: ptr.To("account-0")},
+			},
+			want:    []string{"foo", "bar"},
+			wantErr: false,
+		},
+		{
+			name: "Bad params",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: nil,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045DeleteAssociationsWithResponse: func(ctx context.Context, params *api.SlurmdbV0045DeleteAssociationsParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045DeleteAssociationsResponse, error) {
+							res := &api.SlurmdbV0045DeleteAssociationsResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0045OpenapiAssocsRemovedResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: api.SlurmdbV0045DeleteAssociationsParams{Account: ptr.To("account-0")},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP Error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045DeleteAssociationsWithResponse: func(ctx context.Context, params *api.SlurmdbV0045DeleteAssociationsParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045DeleteAssociationsResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: api.SlurmdbV0045DeleteAssociationsParams{Account: ptr.To("account-0")},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			got, err := c.DeleteAssociations(tt.args.ctx, tt.args.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.DeleteAssociations() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSlurmClient_UpdateAssociation(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx context.Context
+		key string
+		req any
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045PostAssociationsWithResponse: func(ctx context.Context, body api.V0045OpenapiAssocsResp, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045PostAssociationsResponse, error) {
+							assoc := &types.V0045Association{V0045Assoc: body.Associations[0]}
+							if assoc.GetKey() != testAssociationKey {
+								return nil, errors.New("unexpected request body")
+							}
+							res := &api.SlurmdbV0045PostAssociationsResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200:      &api.V0045OpenapiResp{},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+				key: testAssociationKey,
+				req: api.V0045Assoc{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Bad request",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx: context.Background(),
+				key: testAssociationKey,
+				req: nil,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Bad key",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx: context.Background(),
+				key: "foo",
+				req: api.V0045Assoc{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045PostAssociationsWithResponse: func(ctx context.Context, body api.V0045OpenapiAssocsResp, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045PostAssociationsResponse, error) {
+							res := &api.SlurmdbV0045PostAssociationsResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0045OpenapiResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+				key: testAssociationKey,
+				req: api.V0045Assoc{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "HTTP Error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045PostAssociationsWithResponse: func(ctx context.Context, body api.V0045OpenapiAssocsResp, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045PostAssociationsResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+				key: testAssociationKey,
+				req: api.V0045Assoc{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			if err := c.UpdateAssociation(tt.args.ctx, tt.args.key, tt.args.req); (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.UpdateAssociation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSlurmClient_GetAssociation(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx    context.Context
+		key    string
+		params any
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *types.V0045Association
+		wantErr bool
+	}{
+		{
+			name: "Not Found",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx: context.Background(),
+				key: testAssociationKey,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Found",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetAssociationWithResponse: func(ctx context.Context, params *api.SlurmdbV0045GetAssociationParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetAssociationResponse, error) {
+							if ptr.Deref(params.IncludeUsage, "") != "true" {
+								return nil, errors.New("expected params to be passed through")
+							}
+							partition := testAssociation
+							partition.Partition = ptr.To("debug")
+							res := &api.SlurmdbV0045GetAssociationResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiAssocsResp{
+									Associations: api.V0045AssocList{partition, testAssociation},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				key:    testAssociationKey,
+				params: &api.SlurmdbV0045GetAssociationParams{IncludeUsage: ptr.To("true")},
+			},
+			want:    &types.V0045Association{V0045Assoc: testAssociation},
+			wantErr: false,
+		},
+		{
+			name: "Bad params",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				key:    testAssociationKey,
+				params: api.SlurmdbV0045GetAssociationsParams{},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Bad key",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx: context.Background(),
+				key: "foo",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetAssociationWithResponse: func(ctx context.Context, params *api.SlurmdbV0045GetAssociationParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetAssociationResponse, error) {
+							res := &api.SlurmdbV0045GetAssociationResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0045OpenapiAssocsResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+				key: testAssociationKey,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP Error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetAssociationWithResponse: func(ctx context.Context, params *api.SlurmdbV0045GetAssociationParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetAssociationResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+				key: testAssociationKey,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			got, err := c.GetAssociation(tt.args.ctx, tt.args.key, tt.args.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.GetAssociation() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSlurmClient_ListAssociations(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx    context.Context
+		params any
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *types.V0045AssociationList
+		wantErr bool
+	}{
+		{
+			name: "Empty",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: &types.V0045AssociationList{
+				Items: make([]types.V0045Association, 0),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Not Empty",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetAssociationsWithResponse: func(ctx context.Context, params *api.SlurmdbV0045GetAssociationsParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetAssociationsResponse, error) {
+							res := &api.SlurmdbV0045GetAssociationsResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiAssocsResp{
+									Associations: api.V0045AssocList{testAssociation},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: api.SlurmdbV0045GetAssociationsParams{Account: ptr.To("account-0")},
+			},
+			want: &types.V0045AssociationList{
+				Items: []types.V0045Association{
+					{V0045Assoc: testAssociation},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Bad params",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: api.SlurmdbV0045GetAssociationParams{},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetAssociationsWithResponse: func(ctx context.Context, params *api.SlurmdbV0045GetAssociationsParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetAssociationsResponse, error) {
+							res := &api.SlurmdbV0045GetAssociationsResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0045OpenapiAssocsResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP Error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetAssociationsWithResponse: func(ctx context.Context, params *api.SlurmdbV0045GetAssociationsParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetAssociationsResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			got, err := c.ListAssociations(tt.args.ctx, tt.args.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.ListAssociations() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package v0045
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	apierrors "github.com/SlinkyProject/slurm-client/pkg/errors"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+type ClusterInterface interface {
+	CreateCluster(ctx context.Context, req any) (string, error)
+	DeleteCluster(ctx context.Context, name string) error
+	UpdateCluster(ctx context.Context, name string, req any) error
+	GetCluster(ctx context.Context, name string, params any) (*types.V0045Cluster, error)
+	ListClusters(ctx context.Context, params any) (*types.V0045ClusterList, error)
+}
+
+var _ ClusterInterface = &SlurmClient{}
+
+// CreateCluster implements ClientInterface
+func (c *SlurmClient) CreateCluster(ctx context.Context, req any) (string, error) {
+	r, ok := req.(api.V0045ClusterRec)
+	if !ok {
+		return "", errors.New("expected req to be V0045ClusterRec")
+	}
+
+	if err := c.postClusters(ctx, r); err != nil {
+		return "", err
+	}
+
+	return ptr.Deref(r.Name, ""), nil
+}
+
+// DeleteCluster implements ClientInterface
+func (c *SlurmClient) DeleteCluster(ctx context.Context, name string) error {
+	res, err := c.SlurmdbV0045DeleteClusterWithResponse(ctx, name, nil)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return newSlurmError(res.StatusCode(), errs)
+	}
+
+	if len(res.JSON200.DeletedClusters) == 0 {
+		return apierrors.ErrObjectNotFound
+	}
+
+	return nil
+}
+
+// UpdateCluster implements ClientInterface
+func (c *SlurmClient) UpdateCluster(ctx context.Context, name string, req any) error {
+	r, ok := req.(api.V0045ClusterRec)
+	if !ok {
+		return errors.New("expected req to be V0045ClusterRec")
+	}
+
+	// endpoint does not use ID parameter, but make it uniform with the rest that do
+	r.Name = ptr.To(name)
+
+	return c.postClusters(ctx, r)
+}
+
+func (c *SlurmClient) postClusters(ctx context.Context, cluster api.V0045ClusterRec) error {
+	body := api.SlurmdbV0045PostClustersJSONRequestBody{
+		Clusters: api.V0045ClusterRecList{cluster},
+	}
+	res, err := c.SlurmdbV0045PostClustersWithResponse(ctx, nil, body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return newSlurmError(res.StatusCode(), errs)
+	}
+
+	return nil
+}
+
+// GetCluster implements ClientInterface
+func (c *SlurmClient) GetCluster(ctx context.Context, name string, params any) (*types.V0045Cluster, error) {
+	p := &api.SlurmdbV0045GetClusterParams{}
+	switch r := params.(type) {
+	case nil:
+	case api.SlurmdbV0045GetClusterParams:
+		p = &r
+	case *api.SlurmdbV0045GetClusterParams:
+		p = r
+	default:
+		return nil, errors.New("expected params to be SlurmdbV0045GetClusterParams")
+	}
+
+	res, err := c.SlurmdbV0045GetClusterWithResponse(ctx, name, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return nil, newSlurmError(res.StatusCode(), errs)
+	}
+
+	if len(res.JSON200.Clusters) == 0 {
+		return nil, apierrors.ErrObjectNotFound
+	}
+
+	out := &types.V0045Cluster{}
+	utils.RemarshalOrDie(res.JSON200.Clusters[0], out)
+	return out, nil
+}
+
+// ListClusters implements ClientInterface
+func (c *SlurmClient) ListClusters(ctx context.Context, params any) (*types.V0045ClusterList, error) {
+	p := &api.SlurmdbV0045GetClustersParams{}
+	switch r := params.(type) {
+	case nil:
+	case api.SlurmdbV0045GetClustersParams:
+		p = &r
+	case *api.SlurmdbV0045GetClustersParams:
+		p = r
+	default:
+		return nil, errors.New("expected params to be SlurmdbV0045GetClustersParams")
+	}
+
+	res, err := c.SlurmdbV0045GetClustersWithResponse(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return nil, newSlurmError(res.StatusCode(), errs)
+	}
+
+	list := &types.V0045ClusterList{
+		Items: make([]types.V0045Cluster, len(res.JSON200.Clusters)),
+	}
+	for i, item := range res.JSON200.Clusters {
+		utils.RemarshalOrDie(item, &list.Items[i])
+	}
+	return list, nil
+}
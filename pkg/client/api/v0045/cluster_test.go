@@ -0,0 +1,660 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package v0045
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/client/api/v0045/fake"
+	"github.com/SlinkyProject/slurm-client/pkg/client/api/v0045/interceptor"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+)
+
+func TestSlurmClient_CreateCluster(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx context.Context
+		req any
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045PostClustersWithResponse: func(ctx context.Context, params *api.SlurmdbV0045PostClustersParams, body api.V0045OpenapiClustersResp, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045PostClustersResponse, error) {
+							if len(body.Clusters) != 1 || ptr.Deref(body.Clusters[0].Name, "") != "cluster-0" {
+								return nil, errors.New("unexpected request body")
+							}
+							res := &api.SlurmdbV0045PostClustersResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200:      &api.V0045OpenapiResp{},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+				req: api.V0045ClusterRec{Name: ptr.To("cluster-0")},
+			},
+			want:    "cluster-0",
+			wantErr: false,
+		},
+		{
+			name: "Bad request",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx: context.Background(),
+				req: nil,
+			},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045PostClustersWithResponse: func(ctx context.Context, params *api.SlurmdbV0045PostClustersParams, body api.V0045OpenapiClustersResp, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045PostClustersResponse, error) {
+							res := &api.SlurmdbV0045PostClustersResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0045OpenapiResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+				req: api.V0045ClusterRec{Name: ptr.To("cluster-0")},
+			},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name: "HTTP Error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045PostClustersWithResponse: func(ctx context.Context, params *api.SlurmdbV0045PostClustersParams, body api.V0045OpenapiClustersResp, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045PostClustersResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+				req: api.V0045ClusterRec{Name: ptr.To("cluster-0")},
+			},
+			want:    "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			got, err := c.CreateCluster(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.CreateCluster() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSlurmClient_DeleteCluster(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx  context.Context
+		name string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045DeleteClusterWithResponse: func(ctx context.Context, clusterName string, params *api.SlurmdbV0045DeleteClusterParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045DeleteClusterResponse, error) {
+							res := &api.SlurmdbV0045DeleteClusterResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiClustersRemovedResp{
+									DeletedClusters: api.V0045StringList{clusterName},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "cluster-0",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Not Found",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "cluster-0",
+			},
+			wantErr: true,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045DeleteClusterWithResponse: func(ctx context.Context, clusterName string, params *api.SlurmdbV0045DeleteClusterParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045DeleteClusterResponse, error) {
+							res := &api.SlurmdbV0045DeleteClusterResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0045OpenapiClustersRemovedResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "cluster-0",
+			},
+			wantErr: true,
+		},
+		{
+			name: "HTTP Error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045DeleteClusterWithResponse: func(ctx context.Context, clusterName string, params *api.SlurmdbV0045DeleteClusterParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045DeleteClusterResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "cluster-0",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			if err := c.DeleteCluster(tt.args.ctx, tt.args.name); (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.DeleteCluster() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSlurmClient_UpdateCluster(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx  context.Context
+		name string
+		req  any
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045PostClustersWithResponse: func(ctx context.Context, params *api.SlurmdbV0045PostClustersParams, body api.V0045OpenapiClustersResp, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045PostClustersResponse, error) {
+							if len(body.Clusters) != 1 || ptr.Deref(body.Clusters[0].Name, "") != "cluster-0" {
+								return nil, errors.New("unexpected request body")
+							}
+							res := &api.SlurmdbV0045PostClustersResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200:      &api.V0045OpenapiResp{},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "cluster-0",
+				req:  api.V0045ClusterRec{Nodes: ptr.To("foo")},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Bad request",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "cluster-0",
+				req:  nil,
+			},
+			wantErr: true,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045PostClustersWithResponse: func(ctx context.Context, params *api.SlurmdbV0045PostClustersParams, body api.V0045OpenapiClustersResp, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045PostClustersResponse, error) {
+							res := &api.SlurmdbV0045PostClustersResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0045OpenapiResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "cluster-0",
+				req:  api.V0045ClusterRec{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "HTTP Error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045PostClustersWithResponse: func(ctx context.Context, params *api.SlurmdbV0045PostClustersParams, body api.V0045OpenapiClustersResp, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045PostClustersResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "cluster-0",
+				req:  api.V0045ClusterRec{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			if err := c.UpdateCluster(tt.args.ctx, tt.args.name, tt.args.req); (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.UpdateCluster() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSlurmClient_GetCluster(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx    context.Context
+		name   string
+		params any
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *types.V0045Cluster
+		wantErr bool
+	}{
+		{
+			name: "Not Found",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "cluster-0",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Found",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetClusterWithResponse: func(ctx context.Context, clusterName string, params *api.SlurmdbV0045GetClusterParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetClusterResponse, error) {
+							res := &api.SlurmdbV0045GetClusterResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiClustersResp{
+									Clusters: api.V0045ClusterRecList{
+										{Name: ptr.To(clusterName)},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "cluster-0",
+			},
+			want: &types.V0045Cluster{
+				V0045ClusterRec: api.V0045ClusterRec{Name: ptr.To("cluster-0")},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Found with params",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetClusterWithResponse: func(ctx context.Context, clusterName string, params *api.SlurmdbV0045GetClusterParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetClusterResponse, error) {
+							if params.WithDeleted == nil {
+								return nil, errors.New("expected with_deleted param")
+							}
+							res := &api.SlurmdbV0045GetClusterResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiClustersResp{
+									Clusters: api.V0045ClusterRecList{
+										{
+											Name:  ptr.To(clusterName),
+											Flags: &[]api.V0045ClusterRecFlags{api.V0045ClusterRecFlagsDELETED},
+										},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				name:   "cluster-0",
+				params: &api.SlurmdbV0045GetClusterParams{WithDeleted: ptr.To("true")},
+			},
+			want: &types.V0045Cluster{
+				V0045ClusterRec: api.V0045ClusterRec{
+					Name:  ptr.To("cluster-0"),
+					Flags: &[]api.V0045ClusterRecFlags{api.V0045ClusterRecFlagsDELETED},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Bad params",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				name:   "cluster-0",
+				params: api.SlurmdbV0045GetClustersParams{},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetClusterWithResponse: func(ctx context.Context, clusterName string, params *api.SlurmdbV0045GetClusterParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetClusterResponse, error) {
+							res := &api.SlurmdbV0045GetClusterResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0045OpenapiClustersResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "cluster-0",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP Error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetClusterWithResponse: func(ctx context.Context, clusterName string, params *api.SlurmdbV0045GetClusterParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetClusterResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "cluster-0",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			got, err := c.GetCluster(tt.args.ctx, tt.args.name, tt.args.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.GetCluster() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSlurmClient_ListClusters(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx    context.Context
+		params any
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *types.V0045ClusterList
+		wantErr bool
+	}{
+		{
+			name: "Empty",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: &types.V0045ClusterList{
+				Items: make([]types.V0045Cluster, 0),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Not Empty",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetClustersWithResponse: func(ctx context.Context, params *api.SlurmdbV0045GetClustersParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetClustersResponse, error) {
+							res := &api.SlurmdbV0045GetClustersResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiClustersResp{
+									Clusters: api.V0045ClusterRecList{
+										{Name: ptr.To("cluster-0")},
+										{Name: ptr.To("cluster-1")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: api.SlurmdbV0045GetClustersParams{UpdateTime: ptr.To("0")},
+			},
+			want: &types.V0045ClusterList{
+				Items: []types.V0045Cluster{
+					{V0045ClusterRec: api.V0045ClusterRec{Name: ptr.To("cluster-0")}},
+					{V0045ClusterRec: api.V0045ClusterRec{Name: ptr.To("cluster-1")}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Bad params",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: api.SlurmdbV0045GetClusterParams{},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetClustersWithResponse: func(ctx context.Context, params *api.SlurmdbV0045GetClustersParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetClustersResponse, error) {
+							res := &api.SlurmdbV0045GetClustersResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0045OpenapiClustersResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP Error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetClustersWithResponse: func(ctx context.Context, params *api.SlurmdbV0045GetClustersParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetClustersResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			got, err := c.ListClusters(tt.args.ctx, tt.args.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.ListClusters() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
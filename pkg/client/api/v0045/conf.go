@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package v0045
+
+import (
+	"context"
+	"net/http"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+type ConfInterface interface {
+	GetConf(ctx context.Context) (*types.V0045Conf, error)
+	ListConf(ctx context.Context) (*types.V0045ConfList, error)
+}
+
+var _ ConfInterface = &SlurmClient{}
+
+// GetConf implements ClientInterface
+func (c *SlurmClient) GetConf(ctx context.Context) (*types.V0045Conf, error) {
+	params := &api.SlurmV0045GetConfParams{}
+	res, err := c.SlurmV0045GetConfWithResponse(ctx, params)
+	if err != nil {
+		return nil, err
+	} else if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return nil, newSlurmError(res.StatusCode(), errs)
+	}
+	out := &types.V0045Conf{}
+	if res.JSON200.SlurmConf != nil {
+		utils.RemarshalOrDie(res.JSON200.SlurmConf, &out.V0045SlurmConf)
+	}
+	if res.JSON200.SlurmConfMeta != nil {
+		utils.RemarshalOrDie(res.JSON200.SlurmConfMeta, &out.Meta)
+		// NEXT_JOB_ID changes with every submission, drop it so that
+		// only configuration changes are detected by the informer.
+		out.Meta.NEXTJOBID = nil
+	}
+	return out, nil
+}
+
+// ListConf implements ClientInterface
+func (c *SlurmClient) ListConf(ctx context.Context) (*types.V0045ConfList, error) {
+	res, err := c.GetConf(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list := &types.V0045ConfList{
+		Items: []types.V0045Conf{
+			*res,
+		},
+	}
+	return list, nil
+}
@@ -0,0 +1,232 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package v0045
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/client/api/v0045/fake"
+	"github.com/SlinkyProject/slurm-client/pkg/client/api/v0045/interceptor"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+)
+
+func TestSlurmClient_GetConf(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *types.V0045Conf
+		wantErr bool
+	}{
+		{
+			name: "Fetch",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetConfWithResponse: func(ctx context.Context, params *api.SlurmV0045GetConfParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetConfResponse, error) {
+							res := &api.SlurmV0045GetConfResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiConfResp{
+									SlurmConf: &api.V0045SlurmConf{
+										ClusterName: ptr.To("slurm"),
+									},
+									SlurmConfMeta: &api.V0045SlurmConfMeta{
+										HashValue: ptr.To[int32](42),
+										NEXTJOBID: &api.V0045Uint32NoValStruct{Number: ptr.To[int32](1)},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: &types.V0045Conf{
+				V0045SlurmConf: api.V0045SlurmConf{
+					ClusterName: ptr.To("slurm"),
+				},
+				Meta: api.V0045SlurmConfMeta{
+					HashValue: ptr.To[int32](42),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetConfWithResponse: func(ctx context.Context, params *api.SlurmV0045GetConfParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetConfResponse, error) {
+							res := &api.SlurmV0045GetConfResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0045OpenapiConfResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP Error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetConfWithResponse: func(ctx context.Context, params *api.SlurmV0045GetConfParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetConfResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			got, err := c.GetConf(tt.args.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.GetConf() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSlurmClient_ListConf(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *types.V0045ConfList
+		wantErr bool
+	}{
+		{
+			name: "Fetch",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetConfWithResponse: func(ctx context.Context, params *api.SlurmV0045GetConfParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetConfResponse, error) {
+							res := &api.SlurmV0045GetConfResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200:      &api.V0045OpenapiConfResp{},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: &types.V0045ConfList{
+				Items: []types.V0045Conf{
+					{},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetConfWithResponse: func(ctx context.Context, params *api.SlurmV0045GetConfParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetConfResponse, error) {
+							res := &api.SlurmV0045GetConfResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSON200: &api.V0045OpenapiConfResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetConfWithResponse: func(ctx context.Context, params *api.SlurmV0045GetConfParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetConfResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			got, err := c.ListConf(tt.args.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.ListConf() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
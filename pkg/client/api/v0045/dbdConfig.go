@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package v0045
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+)
+
+type DbdConfigInterface interface {
+	GetDbdConfig(ctx context.Context) (*api.V0045OpenapiSlurmdbdConfigResp, error)
+	UpdateDbdConfig(ctx context.Context, req any) error
+}
+
+var _ DbdConfigInterface = &SlurmClient{}
+
+// GetDbdConfig implements ClientInterface
+func (c *SlurmClient) GetDbdConfig(ctx context.Context) (*api.V0045OpenapiSlurmdbdConfigResp, error) {
+	res, err := c.SlurmdbV0045GetConfigWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	} else if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return nil, newSlurmError(res.StatusCode(), errs)
+	}
+	return res.JSON200, nil
+}
+
+// UpdateDbdConfig implements ClientInterface
+func (c *SlurmClient) UpdateDbdConfig(ctx context.Context, req any) error {
+	r, ok := req.(api.V0045OpenapiSlurmdbdConfigResp)
+	if !ok {
+		return errors.New("expected req to be V0045OpenapiSlurmdbdConfigResp")
+	}
+
+	res, err := c.SlurmdbV0045PostConfigWithResponse(ctx, r)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return newSlurmError(res.StatusCode(), errs)
+	}
+
+	return nil
+}
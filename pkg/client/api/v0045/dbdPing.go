@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package v0045
+
+import (
+	"context"
+	"net/http"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	apierrors "github.com/SlinkyProject/slurm-client/pkg/errors"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+type DbdPingInterface interface {
+	GetDbdPing(ctx context.Context, host string) (*types.V0045DbdPing, error)
+	ListDbdPing(ctx context.Context) (*types.V0045DbdPingList, error)
+}
+
+var _ DbdPingInterface = &SlurmClient{}
+
+// GetDbdPing implements ClientInterface
+func (c *SlurmClient) GetDbdPing(ctx context.Context, host string) (*types.V0045DbdPing, error) {
+	list, err := c.ListDbdPing(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range list.Items {
+		if item.Hostname == host {
+			return &item, nil
+		}
+	}
+	return nil, apierrors.ErrObjectNotFound
+}
+
+// ListDbdPing implements ClientInterface
+func (c *SlurmClient) ListDbdPing(ctx context.Context) (*types.V0045DbdPingList, error) {
+	res, err := c.SlurmdbV0045GetPingWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	} else if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return nil, newSlurmError(res.StatusCode(), errs)
+	}
+	list := &types.V0045DbdPingList{
+		Items: make([]types.V0045DbdPing, len(res.JSON200.Pings)),
+	}
+	for i, item := range res.JSON200.Pings {
+		utils.RemarshalOrDie(item, &list.Items[i])
+	}
+	return list, nil
+}
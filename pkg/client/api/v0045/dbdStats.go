@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package v0045
+
+import (
+	"context"
+	"net/http"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+type DbdStatsInterface interface {
+	GetDbdStats(ctx context.Context) (*types.V0045DbdStats, error)
+	ListDbdStats(ctx context.Context) (*types.V0045DbdStatsList, error)
+}
+
+var _ DbdStatsInterface = &SlurmClient{}
+
+// GetDbdStats implements ClientInterface
+func (c *SlurmClient) GetDbdStats(ctx context.Context) (*types.V0045DbdStats, error) {
+	res, err := c.SlurmdbV0045GetDiagWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	} else if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return nil, newSlurmError(res.StatusCode(), errs)
+	}
+	out := &types.V0045DbdStats{}
+	utils.RemarshalOrDie(res.JSON200.Statistics, out)
+	return out, nil
+}
+
+// ListDbdStats implements ClientInterface
+func (c *SlurmClient) ListDbdStats(ctx context.Context) (*types.V0045DbdStatsList, error) {
+	res, err := c.GetDbdStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list := &types.V0045DbdStatsList{
+		Items: []types.V0045DbdStats{
+			*res,
+		},
+	}
+	return list, nil
+}
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package v0045
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	apierrors "github.com/SlinkyProject/slurm-client/pkg/errors"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+type InstanceInterface interface {
+	GetInstance(ctx context.Context, instanceId string) (*types.V0045Instance, error)
+	ListInstances(ctx context.Context, params any) (*types.V0045InstanceList, error)
+}
+
+var _ InstanceInterface = &SlurmClient{}
+
+// GetInstance implements ClientInterface
+func (c *SlurmClient) GetInstance(ctx context.Context, instanceId string) (*types.V0045Instance, error) {
+	params := &api.SlurmdbV0045GetInstanceParams{
+		InstanceId: ptr.To(instanceId),
+	}
+	res, err := c.SlurmdbV0045GetInstanceWithResponse(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return nil, newSlurmError(res.StatusCode(), errs)
+	}
+
+	for _, item := range res.JSON200.Instances {
+		if ptr.Deref(item.InstanceId, "") == instanceId {
+			out := &types.V0045Instance{}
+			utils.RemarshalOrDie(item, out)
+			return out, nil
+		}
+	}
+
+	return nil, apierrors.ErrObjectNotFound
+}
+
+// ListInstances implements ClientInterface
+func (c *SlurmClient) ListInstances(ctx context.Context, params any) (*types.V0045InstanceList, error) {
+	p := &api.SlurmdbV0045GetInstancesParams{}
+	switch r := params.(type) {
+	case nil:
+	case api.SlurmdbV0045GetInstancesParams:
+		p = &r
+	case *api.SlurmdbV0045GetInstancesParams:
+		p = r
+	case *types.V0045InstanceQuery:
+		p = r.Params()
+	default:
+		return nil, errors.New("expected params to be SlurmdbV0045GetInstancesParams or V0045InstanceQuery")
+	}
+
+	res, err := c.SlurmdbV0045GetInstancesWithResponse(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return nil, newSlurmError(res.StatusCode(), errs)
+	}
+
+	list := &types.V0045InstanceList{
+		Items: make([]types.V0045Instance, len(res.JSON200.Instances)),
+	}
+	for i, item := range res.JSON200.Instances {
+		utils.RemarshalOrDie(item, &list.Items[i])
+	}
+	return list, nil
+}
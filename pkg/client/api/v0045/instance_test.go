@@ -0,0 +1,307 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package v0045
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/client/api/v0045/fake"
+	"github.com/SlinkyProject/slurm-client/pkg/client/api/v0045/interceptor"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+)
+
+func TestSlurmClient_GetInstance(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx        context.Context
+		instanceId string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *types.V0045Instance
+		wantErr bool
+	}{
+		{
+			name: "Not Found",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx:        context.Background(),
+				instanceId: "i-1",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Found",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetInstanceWithResponse: func(ctx context.Context, params *api.SlurmdbV0045GetInstanceParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetInstanceResponse, error) {
+							res := &api.SlurmdbV0045GetInstanceResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiInstancesResp{
+									Instances: api.V0045InstanceList{
+										{InstanceId: ptr.To("i-1")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:        context.Background(),
+				instanceId: "i-1",
+			},
+			want: &types.V0045Instance{
+				V0045Instance: api.V0045Instance{InstanceId: ptr.To("i-1")},
+			},
+			wantErr: false,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetInstanceWithResponse: func(ctx context.Context, params *api.SlurmdbV0045GetInstanceParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetInstanceResponse, error) {
+							res := &api.SlurmdbV0045GetInstanceResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0045OpenapiInstancesResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:        context.Background(),
+				instanceId: "i-1",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP Error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetInstanceWithResponse: func(ctx context.Context, params *api.SlurmdbV0045GetInstanceParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetInstanceResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:        context.Background(),
+				instanceId: "i-1",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			got, err := c.GetInstance(tt.args.ctx, tt.args.instanceId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.GetInstance() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSlurmClient_ListInstances(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx    context.Context
+		params any
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *types.V0045InstanceList
+		wantErr bool
+	}{
+		{
+			name: "Empty",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: &types.V0045InstanceList{
+				Items: make([]types.V0045Instance, 0),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Not Empty",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetInstancesWithResponse: func(ctx context.Context, params *api.SlurmdbV0045GetInstancesParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetInstancesResponse, error) {
+							res := &api.SlurmdbV0045GetInstancesResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiInstancesResp{
+									Instances: api.V0045InstanceList{
+										{InstanceId: ptr.To("i-1")},
+										{InstanceId: ptr.To("i-2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: api.SlurmdbV0045GetInstancesParams{NodeList: ptr.To("node-0")},
+			},
+			want: &types.V0045InstanceList{
+				Items: []types.V0045Instance{
+					{V0045Instance: api.V0045Instance{InstanceId: ptr.To("i-1")}},
+					{V0045Instance: api.V0045Instance{InstanceId: ptr.To("i-2")}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Query",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetInstancesWithResponse: func(ctx context.Context, params *api.SlurmdbV0045GetInstancesParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetInstancesResponse, error) {
+							if ptr.Deref(params.NodeList, "") != "node-0" {
+								return nil, errors.New("unexpected params")
+							}
+							res := &api.SlurmdbV0045GetInstancesResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiInstancesResp{
+									Instances: api.V0045InstanceList{
+										{InstanceId: ptr.To("i-1")},
+										{InstanceId: ptr.To("i-2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: types.NewV0045InstanceQuery().WithNodes("node-0"),
+			},
+			want: &types.V0045InstanceList{
+				Items: []types.V0045Instance{
+					{V0045Instance: api.V0045Instance{InstanceId: ptr.To("i-1")}},
+					{V0045Instance: api.V0045Instance{InstanceId: ptr.To("i-2")}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Bad params",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: api.SlurmdbV0045GetQosParams{},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetInstancesWithResponse: func(ctx context.Context, params *api.SlurmdbV0045GetInstancesParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetInstancesResponse, error) {
+							res := &api.SlurmdbV0045GetInstancesResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0045OpenapiInstancesResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP Error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmdbV0045GetInstancesWithResponse: func(ctx context.Context, params *api.SlurmdbV0045GetInstancesParams, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetInstancesResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			got, err := c.ListInstances(tt.args.ctx, tt.args.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.ListInstances() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -8,7 +8,10 @@ import (
 	"errors"
 	"net/http"
 
+	"k8s.io/utils/ptr"
+
 	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	clientapi "github.com/SlinkyProject/slurm-client/pkg/client/api"
 	apierrors "github.com/SlinkyProject/slurm-client/pkg/errors"
 	"github.com/SlinkyProject/slurm-client/pkg/types"
 	"github.com/SlinkyProject/slurm-client/pkg/utils"
@@ -16,10 +19,14 @@ import (
 
 type JobInfoInterface interface {
 	CreateJobInfo(ctx context.Context, req any) (*int32, error)
+	AllocateJobInfo(ctx context.Context, req any) (*int32, error)
 	DeleteJobInfo(ctx context.Context, jobId string) error
+	DeleteJobInfos(ctx context.Context, req any) ([]clientapi.JobResult, error)
 	UpdateJobInfo(ctx context.Context, jobId string, req any) error
 	GetJobInfo(ctx context.Context, jobId string) (*types.V0045JobInfo, error)
 	ListJobInfo(ctx context.Context) (*types.V0045JobInfoList, error)
+	RequeueJobInfo(ctx context.Context, jobId string, flags clientapi.RequeueFlags) ([]clientapi.JobResult, error)
+	RequeueJobInfos(ctx context.Context, jobIds []string, flags clientapi.RequeueFlags) ([]clientapi.JobResult, error)
 }
 
 var _ JobInfoInterface = &SlurmClient{}
@@ -38,11 +45,41 @@ func (c *SlurmClient) CreateJobInfo(ctx context.Context, req any) (*int32, error
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0045OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return nil, errors.Join(errs...)
+		return nil, newSlurmError(res.StatusCode(), errs)
+	}
+
+	return res.JSON200.JobId, nil
+}
+
+// AllocateJobInfo implements ClientInterface. The response only reports the
+// job id; the allocated nodes are only known once the job is running.
+func (c *SlurmClient) AllocateJobInfo(ctx context.Context, req any) (*int32, error) {
+	var r api.V0045JobAllocReq
+	switch v := req.(type) {
+	case api.V0045JobAllocReq:
+		r = v
+	case api.V0045JobDescMsg:
+		r = api.V0045JobAllocReq{Job: &v}
+	default:
+		return nil, errors.New("expected req to be V0045JobAllocReq or V0045JobDescMsg")
+	}
+
+	body := api.SlurmV0045PostJobAllocateJSONRequestBody(r)
+	res, err := c.SlurmV0045PostJobAllocateWithResponse(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return nil, newSlurmError(res.StatusCode(), errs)
 	}
 
 	return res.JSON200.JobId, nil
@@ -57,16 +94,50 @@ func (c *SlurmClient) DeleteJobInfo(ctx context.Context, jobId string) error {
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0045OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return errors.Join(errs...)
+		return newSlurmError(res.StatusCode(), errs)
 	}
 
 	return nil
 }
 
+// DeleteJobInfos implements ClientInterface
+func (c *SlurmClient) DeleteJobInfos(ctx context.Context, req any) ([]clientapi.JobResult, error) {
+	r, ok := req.(api.V0045KillJobsMsg)
+	if !ok {
+		return nil, errors.New("expected req to be V0045KillJobsMsg")
+	}
+
+	body := api.SlurmV0045DeleteJobsJSONRequestBody(r)
+	res, err := c.SlurmV0045DeleteJobsWithResponse(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return nil, newSlurmError(res.StatusCode(), errs)
+	}
+
+	results := make([]clientapi.JobResult, len(res.JSON200.Status))
+	for i, status := range res.JSON200.Status {
+		results[i] = clientapi.JobResult{
+			JobId:  ptr.Deref(status.JobId.Number, 0),
+			StepId: status.StepId,
+		}
+		if status.Error != nil && ptr.Deref(status.Error.Code, 0) != 0 {
+			results[i].Error = newJobError(*status.Error.Code, ptr.Deref(status.Error.String, ""), ptr.Deref(status.Error.Message, ""))
+		}
+	}
+	return results, nil
+}
+
 // UpdateJobInfo implements ClientInterface
 func (c *SlurmClient) UpdateJobInfo(ctx context.Context, jobId string, req any) error {
 	r, ok := req.(api.V0045JobDescMsg)
@@ -81,11 +152,11 @@ func (c *SlurmClient) UpdateJobInfo(ctx context.Context, jobId string, req any)
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0045OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return errors.Join(errs...)
+		return newSlurmError(res.StatusCode(), errs)
 	}
 
 	return nil
@@ -100,11 +171,11 @@ func (c *SlurmClient) GetJobInfo(ctx context.Context, jobId string) (*types.V004
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0045OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return nil, errors.Join(errs...)
+		return nil, newSlurmError(res.StatusCode(), errs)
 	}
 
 	if len(res.JSON200.Jobs) == 0 {
@@ -125,11 +196,11 @@ func (c *SlurmClient) ListJobInfo(ctx context.Context) (*types.V0045JobInfoList,
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0045OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return nil, errors.Join(errs...)
+		return nil, newSlurmError(res.StatusCode(), errs)
 	}
 
 	list := &types.V0045JobInfoList{
@@ -140,3 +211,83 @@ func (c *SlurmClient) ListJobInfo(ctx context.Context) (*types.V0045JobInfoList,
 	}
 	return list, nil
 }
+
+// RequeueJobInfo implements ClientInterface
+func (c *SlurmClient) RequeueJobInfo(ctx context.Context, jobId string, flags clientapi.RequeueFlags) ([]clientapi.JobResult, error) {
+	params := &api.SlurmV0045GetJobRequeueParams{}
+	if flags.Hold {
+		params.Hold = ptr.To("true")
+	}
+	if flags.SpecialExit {
+		params.SpecialExit = ptr.To("true")
+	}
+	if flags.Incomplete {
+		params.Incomplete = ptr.To("true")
+	}
+	res, err := c.SlurmV0045GetJobRequeueWithResponse(ctx, jobId, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return nil, newSlurmError(res.StatusCode(), errs)
+	}
+
+	return getJobResults(res.JSON200.Status), nil
+}
+
+// RequeueJobInfos implements ClientInterface
+func (c *SlurmClient) RequeueJobInfos(ctx context.Context, jobIds []string, flags clientapi.RequeueFlags) ([]clientapi.JobResult, error) {
+	body := api.SlurmV0045PostJobsRequeueJSONRequestBody{
+		Jobs: &jobIds,
+	}
+	requeueFlags := []api.V0045OpenapiJobsRequeueQueryFlags{}
+	if flags.Hold {
+		requeueFlags = append(requeueFlags, api.Hold)
+	}
+	if flags.SpecialExit {
+		requeueFlags = append(requeueFlags, api.SpecialExit)
+	}
+	if flags.Incomplete {
+		requeueFlags = append(requeueFlags, api.Incomplete)
+	}
+	if len(requeueFlags) > 0 {
+		body.Flags = &requeueFlags
+	}
+	res, err := c.SlurmV0045PostJobsRequeueWithResponse(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return nil, newSlurmError(res.StatusCode(), errs)
+	}
+
+	results := []clientapi.JobResult{}
+	for _, status := range res.JSON200.Status {
+		results = append(results, getJobResults(status)...)
+	}
+	return results, nil
+}
+
+func getJobResults(entries api.V0045JobArrayResponseArray) []clientapi.JobResult {
+	results := make([]clientapi.JobResult, len(entries))
+	for i, entry := range entries {
+		results[i] = clientapi.JobResult{
+			JobId:  ptr.Deref(entry.JobId, 0),
+			StepId: ptr.Deref(entry.StepId, ""),
+		}
+		if ptr.Deref(entry.ErrorCode, 0) != 0 {
+			results[i].Error = newJobError(*entry.ErrorCode, ptr.Deref(entry.Error, ""), ptr.Deref(entry.Why, ""))
+		}
+	}
+	return results
+}
@@ -14,8 +14,10 @@ import (
 	"k8s.io/utils/ptr"
 
 	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	clientapi "github.com/SlinkyProject/slurm-client/pkg/client/api"
 	"github.com/SlinkyProject/slurm-client/pkg/client/api/v0045/fake"
 	"github.com/SlinkyProject/slurm-client/pkg/client/api/v0045/interceptor"
+	apierrors "github.com/SlinkyProject/slurm-client/pkg/errors"
 	"github.com/SlinkyProject/slurm-client/pkg/types"
 )
 
@@ -58,6 +60,18 @@ func TestSlurmClient_CreateJobInfo(t *testing.T) {
 			want:    ptr.To[int32](1),
 			wantErr: false,
 		},
+		{
+			name: "Allocation request",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx: context.Background(),
+				req: api.V0045JobAllocReq{},
+			},
+			want:    nil,
+			wantErr: true,
+		},
 		{
 			name: "Bad request",
 			fields: fields{
@@ -146,43 +160,105 @@ func TestSlurmClient_CreateJobInfo(t *testing.T) {
 	}
 }
 
-func TestSlurmClient_DeleteJobInfo(t *testing.T) {
+func TestSlurmClient_AllocateJobInfo(t *testing.T) {
 	type fields struct {
 		ClientWithResponsesInterface api.ClientWithResponsesInterface
 	}
 	type args struct {
-		ctx   context.Context
-		jobId string
+		ctx context.Context
+		req any
 	}
 	tests := []struct {
 		name    string
 		fields  fields
 		args    args
+		want    *int32
 		wantErr bool
 	}{
 		{
-			name: "Found",
+			name: "Success",
 			fields: fields{
-				ClientWithResponsesInterface: fake.NewFakeClient(),
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045PostJobAllocateWithResponse: func(ctx context.Context, body api.V0045JobAllocReq, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045PostJobAllocateResponse, error) {
+							res := &api.SlurmV0045PostJobAllocateResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiJobAllocResp{
+									JobId: ptr.To[int32](1),
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
 			},
 			args: args{
-				ctx:   context.Background(),
-				jobId: "1",
+				ctx: context.Background(),
+				req: api.V0045JobAllocReq{},
 			},
+			want:    ptr.To[int32](1),
+			wantErr: false,
+		},
+		{
+			name: "Success with job description",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045PostJobAllocateWithResponse: func(ctx context.Context, body api.V0045JobAllocReq, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045PostJobAllocateResponse, error) {
+							res := &api.SlurmV0045PostJobAllocateResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiJobAllocResp{
+									JobId: ptr.To[int32](1),
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+				req: api.V0045JobDescMsg{},
+			},
+			want:    ptr.To[int32](1),
 			wantErr: false,
 		},
+		{
+			name: "Bad request",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045PostJobAllocateWithResponse: func(ctx context.Context, body api.V0045JobAllocReq, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045PostJobAllocateResponse, error) {
+							res := &api.SlurmV0045PostJobAllocateResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiJobAllocResp{
+									JobId: ptr.To[int32](1),
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+				req: nil,
+			},
+			want:    nil,
+			wantErr: true,
+		},
 		{
 			name: "HTTP Status != 200",
 			fields: fields{
 				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
 					WithInterceptorFuncs(interceptor.Funcs{
-						SlurmV0045DeleteJobWithResponse: func(ctx context.Context, jobId string, params *api.SlurmV0045DeleteJobParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045DeleteJobResponse, error) {
-							res := &api.SlurmV0045DeleteJobResponse{
+						SlurmV0045PostJobAllocateWithResponse: func(ctx context.Context, body api.V0045JobAllocReq, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045PostJobAllocateResponse, error) {
+							res := &api.SlurmV0045PostJobAllocateResponse{
 								HTTPResponse: &http.Response{
 									Status:     http.StatusText(http.StatusInternalServerError),
 									StatusCode: http.StatusInternalServerError,
 								},
-								JSONDefault: &api.V0045OpenapiKillJobResp{
+								JSONDefault: &api.V0045OpenapiJobAllocResp{
 									Errors: &[]api.V0045OpenapiError{
 										{Error: ptr.To("error 1")},
 										{Error: ptr.To("error 2")},
@@ -195,9 +271,10 @@ func TestSlurmClient_DeleteJobInfo(t *testing.T) {
 					Build(),
 			},
 			args: args{
-				ctx:   context.Background(),
-				jobId: "1",
+				ctx: context.Background(),
+				req: api.V0045JobAllocReq{},
 			},
+			want:    nil,
 			wantErr: true,
 		},
 		{
@@ -205,16 +282,17 @@ func TestSlurmClient_DeleteJobInfo(t *testing.T) {
 			fields: fields{
 				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
 					WithInterceptorFuncs(interceptor.Funcs{
-						SlurmV0045DeleteJobWithResponse: func(ctx context.Context, jobId string, params *api.SlurmV0045DeleteJobParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045DeleteJobResponse, error) {
+						SlurmV0045PostJobAllocateWithResponse: func(ctx context.Context, body api.V0045JobAllocReq, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045PostJobAllocateResponse, error) {
 							return nil, errors.New(http.StatusText(http.StatusBadGateway))
 						},
 					}).
 					Build(),
 			},
 			args: args{
-				ctx:   context.Background(),
-				jobId: "1",
+				ctx: context.Background(),
+				req: api.V0045JobAllocReq{},
 			},
+			want:    nil,
 			wantErr: true,
 		},
 	}
@@ -223,21 +301,23 @@ func TestSlurmClient_DeleteJobInfo(t *testing.T) {
 			c := &SlurmClient{
 				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
 			}
-			if err := c.DeleteJobInfo(tt.args.ctx, tt.args.jobId); (err != nil) != tt.wantErr {
-				t.Errorf("SlurmClient.DeleteJobInfo() error = %v, wantErr %v", err, tt.wantErr)
+			got, err := c.AllocateJobInfo(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.AllocateJobInfo() error = %v, wantErr %v", err, tt.wantErr)
+				return
 			}
+			require.Equal(t, tt.want, got)
 		})
 	}
 }
 
-func TestSlurmClient_UpdateJobInfo(t *testing.T) {
+func TestSlurmClient_DeleteJobInfo(t *testing.T) {
 	type fields struct {
 		ClientWithResponsesInterface api.ClientWithResponsesInterface
 	}
 	type args struct {
 		ctx   context.Context
 		jobId string
-		req   any
 	}
 	tests := []struct {
 		name    string
@@ -246,14 +326,13 @@ func TestSlurmClient_UpdateJobInfo(t *testing.T) {
 		wantErr bool
 	}{
 		{
-			name: "Update existing job",
+			name: "Found",
 			fields: fields{
 				ClientWithResponsesInterface: fake.NewFakeClient(),
 			},
 			args: args{
 				ctx:   context.Background(),
 				jobId: "1",
-				req:   api.V0045JobDescMsg{},
 			},
 			wantErr: false,
 		},
@@ -262,13 +341,13 @@ func TestSlurmClient_UpdateJobInfo(t *testing.T) {
 			fields: fields{
 				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
 					WithInterceptorFuncs(interceptor.Funcs{
-						SlurmV0045PostJobWithResponse: func(ctx context.Context, jobId string, body api.V0045JobDescMsg, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045PostJobResponse, error) {
-							res := &api.SlurmV0045PostJobResponse{
+						SlurmV0045DeleteJobWithResponse: func(ctx context.Context, jobId string, params *api.SlurmV0045DeleteJobParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045DeleteJobResponse, error) {
+							res := &api.SlurmV0045DeleteJobResponse{
 								HTTPResponse: &http.Response{
 									Status:     http.StatusText(http.StatusInternalServerError),
 									StatusCode: http.StatusInternalServerError,
 								},
-								JSONDefault: &api.V0045OpenapiJobPostResponse{
+								JSONDefault: &api.V0045OpenapiKillJobResp{
 									Errors: &[]api.V0045OpenapiError{
 										{Error: ptr.To("error 1")},
 										{Error: ptr.To("error 2")},
@@ -283,7 +362,6 @@ func TestSlurmClient_UpdateJobInfo(t *testing.T) {
 			args: args{
 				ctx:   context.Background(),
 				jobId: "1",
-				req:   api.V0045JobDescMsg{},
 			},
 			wantErr: true,
 		},
@@ -292,7 +370,7 @@ func TestSlurmClient_UpdateJobInfo(t *testing.T) {
 			fields: fields{
 				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
 					WithInterceptorFuncs(interceptor.Funcs{
-						SlurmV0045PostJobWithResponse: func(ctx context.Context, jobId string, body api.V0045JobDescMsg, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045PostJobResponse, error) {
+						SlurmV0045DeleteJobWithResponse: func(ctx context.Context, jobId string, params *api.SlurmV0045DeleteJobParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045DeleteJobResponse, error) {
 							return nil, errors.New(http.StatusText(http.StatusBadGateway))
 						},
 					}).
@@ -300,7 +378,7 @@ func TestSlurmClient_UpdateJobInfo(t *testing.T) {
 			},
 			args: args{
 				ctx:   context.Background(),
-				jobId: "0",
+				jobId: "1",
 			},
 			wantErr: true,
 		},
@@ -310,56 +388,81 @@ func TestSlurmClient_UpdateJobInfo(t *testing.T) {
 			c := &SlurmClient{
 				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
 			}
-			if err := c.UpdateJobInfo(tt.args.ctx, tt.args.jobId, tt.args.req); (err != nil) != tt.wantErr {
-				t.Errorf("SlurmClient.UpdateJobInfo() error = %v, wantErr %v", err, tt.wantErr)
+			if err := c.DeleteJobInfo(tt.args.ctx, tt.args.jobId); (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.DeleteJobInfo() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
 }
 
-func TestSlurmClient_GetJobInfo(t *testing.T) {
+func TestSlurmClient_DeleteJobInfos(t *testing.T) {
 	type fields struct {
 		ClientWithResponsesInterface api.ClientWithResponsesInterface
 	}
 	type args struct {
-		ctx   context.Context
-		jobId string
+		ctx context.Context
+		req any
 	}
 	tests := []struct {
 		name    string
 		fields  fields
 		args    args
-		want    *types.V0045JobInfo
+		want    []clientapi.JobResult
 		wantErr bool
 	}{
 		{
-			name: "Not Found",
+			name: "Success",
 			fields: fields{
-				ClientWithResponsesInterface: fake.NewFakeClient(),
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045DeleteJobsWithResponse: func(ctx context.Context, body api.V0045KillJobsMsg, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045DeleteJobsResponse, error) {
+							res := &api.SlurmV0045DeleteJobsResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiKillJobsResp{
+									Status: api.V0045KillJobsRespMsg{
+										{JobId: api.V0045Uint32NoValStruct{Number: ptr.To[int32](1), Set: ptr.To(true)}, StepId: "1"},
+										{JobId: api.V0045Uint32NoValStruct{Number: ptr.To[int32](2), Set: ptr.To(true)}, StepId: "2"},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
 			},
 			args: args{
-				ctx:   context.Background(),
-				jobId: "1",
+				ctx: context.Background(),
+				req: api.V0045KillJobsMsg{Account: ptr.To("foo")},
 			},
-			want:    nil,
-			wantErr: true,
+			want: []clientapi.JobResult{
+				{JobId: 1, StepId: "1"},
+				{JobId: 2, StepId: "2"},
+			},
+			wantErr: false,
 		},
 		{
-			name: "Found",
+			name: "Partial failure",
 			fields: fields{
 				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
 					WithInterceptorFuncs(interceptor.Funcs{
-						SlurmV0045GetJobWithResponse: func(ctx context.Context, jobId string, params *api.SlurmV0045GetJobParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetJobResponse, error) {
-							jid, err := strconv.Atoi(jobId)
-							if err != nil {
-								t.Fatal(err)
+						SlurmV0045DeleteJobsWithResponse: func(ctx context.Context, body api.V0045KillJobsMsg, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045DeleteJobsResponse, error) {
+							status := api.V0045KillJobsRespMsg{
+								{JobId: api.V0045Uint32NoValStruct{Number: ptr.To[int32](1), Set: ptr.To(true)}, StepId: "1"},
+								{JobId: api.V0045Uint32NoValStruct{Number: ptr.To[int32](2), Set: ptr.To(true)}, StepId: "2"},
 							}
-							res := &api.SlurmV0045GetJobResponse{
+							status[1].Error = &struct {
+								Code    *int32  `json:"code,omitempty"`
+								Message *string `json:"message,omitempty"`
+								String  *string `json:"string,omitempty"`
+							}{
+								Code:    ptr.To[int32](2021),
+								Message: ptr.To("Job already completed"),
+								String:  ptr.To("Job/step already completing or completed"),
+							}
+							res := &api.SlurmV0045DeleteJobsResponse{
 								HTTPResponse: &fake.HttpSuccess,
-								JSON200: &api.V0045OpenapiJobInfoResp{
-									Jobs: []api.V0045JobInfo{
-										{JobId: ptr.To(int32(jid))}, //nolint:gosec // disable G109
-									},
+								JSON200: &api.V0045OpenapiKillJobsResp{
+									Status: status,
 								},
 							}
 							return res, nil
@@ -368,28 +471,39 @@ func TestSlurmClient_GetJobInfo(t *testing.T) {
 					Build(),
 			},
 			args: args{
-				ctx:   context.Background(),
-				jobId: "1",
+				ctx: context.Background(),
+				req: api.V0045KillJobsMsg{Account: ptr.To("foo")},
 			},
-			want: &types.V0045JobInfo{
-				V0045JobInfo: api.V0045JobInfo{
-					JobId: ptr.To[int32](1),
-				},
+			want: []clientapi.JobResult{
+				{JobId: 1, StepId: "1"},
+				{JobId: 2, StepId: "2", Error: &apierrors.SlurmError{Errors: []apierrors.SlurmErrorEntry{{ErrorNumber: apierrors.ESLURM_ALREADY_DONE, Error: "Job/step already completing or completed: Job already completed"}}}},
 			},
 			wantErr: false,
 		},
+		{
+			name: "Bad request",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+				req: nil,
+			},
+			want:    nil,
+			wantErr: true,
+		},
 		{
 			name: "HTTP Status != 200",
 			fields: fields{
 				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
 					WithInterceptorFuncs(interceptor.Funcs{
-						SlurmV0045GetJobWithResponse: func(ctx context.Context, jobId string, params *api.SlurmV0045GetJobParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetJobResponse, error) {
-							res := &api.SlurmV0045GetJobResponse{
+						SlurmV0045DeleteJobsWithResponse: func(ctx context.Context, body api.V0045KillJobsMsg, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045DeleteJobsResponse, error) {
+							res := &api.SlurmV0045DeleteJobsResponse{
 								HTTPResponse: &http.Response{
 									Status:     http.StatusText(http.StatusInternalServerError),
 									StatusCode: http.StatusInternalServerError,
 								},
-								JSONDefault: &api.V0045OpenapiJobInfoResp{
+								JSONDefault: &api.V0045OpenapiKillJobsResp{
 									Errors: &[]api.V0045OpenapiError{
 										{Error: ptr.To("error 1")},
 										{Error: ptr.To("error 2")},
@@ -402,8 +516,8 @@ func TestSlurmClient_GetJobInfo(t *testing.T) {
 					Build(),
 			},
 			args: args{
-				ctx:   context.Background(),
-				jobId: "1",
+				ctx: context.Background(),
+				req: api.V0045KillJobsMsg{Account: ptr.To("foo")},
 			},
 			want:    nil,
 			wantErr: true,
@@ -413,15 +527,15 @@ func TestSlurmClient_GetJobInfo(t *testing.T) {
 			fields: fields{
 				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
 					WithInterceptorFuncs(interceptor.Funcs{
-						SlurmV0045GetJobWithResponse: func(ctx context.Context, jobId string, params *api.SlurmV0045GetJobParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetJobResponse, error) {
+						SlurmV0045DeleteJobsWithResponse: func(ctx context.Context, body api.V0045KillJobsMsg, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045DeleteJobsResponse, error) {
 							return nil, errors.New(http.StatusText(http.StatusBadGateway))
 						},
 					}).
 					Build(),
 			},
 			args: args{
-				ctx:   context.Background(),
-				jobId: "1",
+				ctx: context.Background(),
+				req: api.V0045KillJobsMsg{Account: ptr.To("foo")},
 			},
 			want:    nil,
 			wantErr: true,
@@ -432,9 +546,9 @@ func TestSlurmClient_GetJobInfo(t *testing.T) {
 			c := &SlurmClient{
 				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
 			}
-			got, err := c.GetJobInfo(tt.args.ctx, tt.args.jobId)
+			got, err := c.DeleteJobInfos(tt.args.ctx, tt.args.req)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("SlurmClient.GetJobInfo() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("SlurmClient.DeleteJobInfos() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			require.Equal(t, tt.want, got)
@@ -442,63 +556,30 @@ func TestSlurmClient_GetJobInfo(t *testing.T) {
 	}
 }
 
-func TestSlurmClient_ListJobInfo(t *testing.T) {
+func TestSlurmClient_UpdateJobInfo(t *testing.T) {
 	type fields struct {
 		ClientWithResponsesInterface api.ClientWithResponsesInterface
 	}
 	type args struct {
-		ctx context.Context
+		ctx   context.Context
+		jobId string
+		req   any
 	}
 	tests := []struct {
 		name    string
 		fields  fields
 		args    args
-		want    *types.V0045JobInfoList
 		wantErr bool
 	}{
 		{
-			name: "Empty list",
+			name: "Update existing job",
 			fields: fields{
 				ClientWithResponsesInterface: fake.NewFakeClient(),
 			},
 			args: args{
-				ctx: context.Background(),
-			},
-			want: &types.V0045JobInfoList{
-				Items: make([]types.V0045JobInfo, 0),
-			},
-			wantErr: false,
-		},
-		{
-			name: "Non-empty list",
-			fields: fields{
-				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
-					WithInterceptorFuncs(interceptor.Funcs{
-						SlurmV0045GetJobsWithResponse: func(ctx context.Context, params *api.SlurmV0045GetJobsParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetJobsResponse, error) {
-							res := &api.SlurmV0045GetJobsResponse{
-								HTTPResponse: &fake.HttpSuccess,
-								JSON200: &api.V0045OpenapiJobInfoResp{
-									Jobs: []api.V0045JobInfo{
-										{JobId: ptr.To[int32](1)},
-										{JobId: ptr.To[int32](2)},
-										{JobId: ptr.To[int32](3)},
-									},
-								},
-							}
-							return res, nil
-						},
-					}).
-					Build(),
-			},
-			args: args{
-				ctx: context.Background(),
-			},
-			want: &types.V0045JobInfoList{
-				Items: []types.V0045JobInfo{
-					{V0045JobInfo: api.V0045JobInfo{JobId: ptr.To[int32](1)}},
-					{V0045JobInfo: api.V0045JobInfo{JobId: ptr.To[int32](2)}},
-					{V0045JobInfo: api.V0045JobInfo{JobId: ptr.To[int32](3)}},
-				},
+				ctx:   context.Background(),
+				jobId: "1",
+				req:   api.V0045JobDescMsg{},
 			},
 			wantErr: false,
 		},
@@ -507,12 +588,18 @@ func TestSlurmClient_ListJobInfo(t *testing.T) {
 			fields: fields{
 				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
 					WithInterceptorFuncs(interceptor.Funcs{
-						SlurmV0045GetJobsWithResponse: func(ctx context.Context, params *api.SlurmV0045GetJobsParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetJobsResponse, error) {
-							res := &api.SlurmV0045GetJobsResponse{
+						SlurmV0045PostJobWithResponse: func(ctx context.Context, jobId string, body api.V0045JobDescMsg, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045PostJobResponse, error) {
+							res := &api.SlurmV0045PostJobResponse{
 								HTTPResponse: &http.Response{
 									Status:     http.StatusText(http.StatusInternalServerError),
 									StatusCode: http.StatusInternalServerError,
 								},
+								JSONDefault: &api.V0045OpenapiJobPostResponse{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
 							}
 							return res, nil
 						},
@@ -520,26 +607,27 @@ func TestSlurmClient_ListJobInfo(t *testing.T) {
 					Build(),
 			},
 			args: args{
-				ctx: context.Background(),
+				ctx:   context.Background(),
+				jobId: "1",
+				req:   api.V0045JobDescMsg{},
 			},
-			want:    nil,
 			wantErr: true,
 		},
 		{
-			name: "HTTP error",
+			name: "HTTP Error",
 			fields: fields{
 				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
 					WithInterceptorFuncs(interceptor.Funcs{
-						SlurmV0045GetJobsWithResponse: func(ctx context.Context, params *api.SlurmV0045GetJobsParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetJobsResponse, error) {
+						SlurmV0045PostJobWithResponse: func(ctx context.Context, jobId string, body api.V0045JobDescMsg, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045PostJobResponse, error) {
 							return nil, errors.New(http.StatusText(http.StatusBadGateway))
 						},
 					}).
 					Build(),
 			},
 			args: args{
-				ctx: context.Background(),
+				ctx:   context.Background(),
+				jobId: "0",
 			},
-			want:    nil,
 			wantErr: true,
 		},
 	}
@@ -548,9 +636,601 @@ func TestSlurmClient_ListJobInfo(t *testing.T) {
 			c := &SlurmClient{
 				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
 			}
-			got, err := c.ListJobInfo(tt.args.ctx)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("SlurmClient.ListJobInfo() error = %v, wantErr %v", err, tt.wantErr)
+			if err := c.UpdateJobInfo(tt.args.ctx, tt.args.jobId, tt.args.req); (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.UpdateJobInfo() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSlurmClient_GetJobInfo(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx   context.Context
+		jobId string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *types.V0045JobInfo
+		wantErr bool
+	}{
+		{
+			name: "Not Found",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				jobId: "1",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Found",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetJobWithResponse: func(ctx context.Context, jobId string, params *api.SlurmV0045GetJobParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetJobResponse, error) {
+							jid, err := strconv.Atoi(jobId)
+							if err != nil {
+								t.Fatal(err)
+							}
+							res := &api.SlurmV0045GetJobResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiJobInfoResp{
+									Jobs: []api.V0045JobInfo{
+										{JobId: ptr.To(int32(jid))}, //nolint:gosec // disable G109
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				jobId: "1",
+			},
+			want: &types.V0045JobInfo{
+				V0045JobInfo: api.V0045JobInfo{
+					JobId: ptr.To[int32](1),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetJobWithResponse: func(ctx context.Context, jobId string, params *api.SlurmV0045GetJobParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetJobResponse, error) {
+							res := &api.SlurmV0045GetJobResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0045OpenapiJobInfoResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				jobId: "1",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP Error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetJobWithResponse: func(ctx context.Context, jobId string, params *api.SlurmV0045GetJobParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetJobResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				jobId: "1",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			got, err := c.GetJobInfo(tt.args.ctx, tt.args.jobId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.GetJobInfo() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSlurmClient_ListJobInfo(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *types.V0045JobInfoList
+		wantErr bool
+	}{
+		{
+			name: "Empty list",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: &types.V0045JobInfoList{
+				Items: make([]types.V0045JobInfo, 0),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Non-empty list",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetJobsWithResponse: func(ctx context.Context, params *api.SlurmV0045GetJobsParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetJobsResponse, error) {
+							res := &api.SlurmV0045GetJobsResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiJobInfoResp{
+									Jobs: []api.V0045JobInfo{
+										{JobId: ptr.To[int32](1)},
+										{JobId: ptr.To[int32](2)},
+										{JobId: ptr.To[int32](3)},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: &types.V0045JobInfoList{
+				Items: []types.V0045JobInfo{
+					{V0045JobInfo: api.V0045JobInfo{JobId: ptr.To[int32](1)}},
+					{V0045JobInfo: api.V0045JobInfo{JobId: ptr.To[int32](2)}},
+					{V0045JobInfo: api.V0045JobInfo{JobId: ptr.To[int32](3)}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetJobsWithResponse: func(ctx context.Context, params *api.SlurmV0045GetJobsParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetJobsResponse, error) {
+							res := &api.SlurmV0045GetJobsResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetJobsWithResponse: func(ctx context.Context, params *api.SlurmV0045GetJobsParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetJobsResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			got, err := c.ListJobInfo(tt.args.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.ListJobInfo() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSlurmClient_RequeueJobInfo(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx   context.Context
+		jobId string
+		flags clientapi.RequeueFlags
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []clientapi.JobResult
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetJobRequeueWithResponse: func(ctx context.Context, jobId string, params *api.SlurmV0045GetJobRequeueParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetJobRequeueResponse, error) {
+							res := &api.SlurmV0045GetJobRequeueResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiJobRequeueResp{
+									Status: api.V0045JobArrayResponseArray{
+										{JobId: ptr.To[int32](1), ErrorCode: ptr.To[int32](0)},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				jobId: "1",
+			},
+			want: []clientapi.JobResult{
+				{JobId: 1},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success with flags",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetJobRequeueWithResponse: func(ctx context.Context, jobId string, params *api.SlurmV0045GetJobRequeueParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetJobRequeueResponse, error) {
+							if params.Hold == nil || params.SpecialExit == nil || params.Incomplete != nil {
+								return nil, errors.New("unexpected params")
+							}
+							res := &api.SlurmV0045GetJobRequeueResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiJobRequeueResp{
+									Status: api.V0045JobArrayResponseArray{
+										{JobId: ptr.To[int32](1)},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				jobId: "1",
+				flags: clientapi.RequeueFlags{Hold: true, SpecialExit: true},
+			},
+			want: []clientapi.JobResult{
+				{JobId: 1},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Job error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetJobRequeueWithResponse: func(ctx context.Context, jobId string, params *api.SlurmV0045GetJobRequeueParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetJobRequeueResponse, error) {
+							res := &api.SlurmV0045GetJobRequeueResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiJobRequeueResp{
+									Status: api.V0045JobArrayResponseArray{
+										{
+											JobId:     ptr.To[int32](1),
+											ErrorCode: ptr.To[int32](2017),
+											Error:     ptr.To("Invalid job id specified"),
+										},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				jobId: "1",
+			},
+			want: []clientapi.JobResult{
+				{JobId: 1, Error: &apierrors.SlurmError{Errors: []apierrors.SlurmErrorEntry{{ErrorNumber: apierrors.ESLURM_INVALID_JOB_ID, Error: "Invalid job id specified"}}}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetJobRequeueWithResponse: func(ctx context.Context, jobId string, params *api.SlurmV0045GetJobRequeueParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetJobRequeueResponse, error) {
+							res := &api.SlurmV0045GetJobRequeueResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0045OpenapiJobRequeueResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				jobId: "1",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP Error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetJobRequeueWithResponse: func(ctx context.Context, jobId string, params *api.SlurmV0045GetJobRequeueParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetJobRequeueResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				jobId: "1",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			got, err := c.RequeueJobInfo(tt.args.ctx, tt.args.jobId, tt.args.flags)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.RequeueJobInfo() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSlurmClient_RequeueJobInfos(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx    context.Context
+		jobIds []string
+		flags  clientapi.RequeueFlags
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []clientapi.JobResult
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045PostJobsRequeueWithResponse: func(ctx context.Context, body api.SlurmV0045PostJobsRequeueJSONRequestBody, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045PostJobsRequeueResponse, error) {
+							status := api.V0045JobArrayResponseMsgPtrList{}
+							for _, jobId := range ptr.Deref(body.Jobs, nil) {
+								id, _ := strconv.Atoi(jobId)
+								status = append(status, api.V0045JobArrayResponseArray{
+									{JobId: ptr.To(int32(id))},
+								})
+							}
+							res := &api.SlurmV0045PostJobsRequeueResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiJobsRequeueResp{
+									Status: status,
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				jobIds: []string{"1", "2"},
+			},
+			want: []clientapi.JobResult{
+				{JobId: 1},
+				{JobId: 2},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success with flags",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045PostJobsRequeueWithResponse: func(ctx context.Context, body api.SlurmV0045PostJobsRequeueJSONRequestBody, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045PostJobsRequeueResponse, error) {
+							want := []api.V0045OpenapiJobsRequeueQueryFlags{api.Hold, api.Incomplete}
+							if body.Flags == nil || len(*body.Flags) != len(want) {
+								return nil, errors.New("unexpected flags")
+							}
+							res := &api.SlurmV0045PostJobsRequeueResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiJobsRequeueResp{
+									Status: api.V0045JobArrayResponseMsgPtrList{
+										{{JobId: ptr.To[int32](1)}},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				jobIds: []string{"1"},
+				flags:  clientapi.RequeueFlags{Hold: true, Incomplete: true},
+			},
+			want: []clientapi.JobResult{
+				{JobId: 1},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Partial failure",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045PostJobsRequeueWithResponse: func(ctx context.Context, body api.SlurmV0045PostJobsRequeueJSONRequestBody, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045PostJobsRequeueResponse, error) {
+							res := &api.SlurmV0045PostJobsRequeueResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiJobsRequeueResp{
+									Status: api.V0045JobArrayResponseMsgPtrList{
+										{{JobId: ptr.To[int32](1)}},
+										{{
+											JobId:     ptr.To[int32](2),
+											ErrorCode: ptr.To[int32](2021),
+											Error:     ptr.To("Job/step already completing or completed"),
+											Why:       ptr.To("job is pending"),
+										}},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				jobIds: []string{"1", "2"},
+			},
+			want: []clientapi.JobResult{
+				{JobId: 1},
+				{JobId: 2, Error: &apierrors.SlurmError{Errors: []apierrors.SlurmErrorEntry{{ErrorNumber: apierrors.ESLURM_ALREADY_DONE, Error: "Job/step already completing or completed: job is pending"}}}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045PostJobsRequeueWithResponse: func(ctx context.Context, body api.SlurmV0045PostJobsRequeueJSONRequestBody, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045PostJobsRequeueResponse, error) {
+							res := &api.SlurmV0045PostJobsRequeueResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0045OpenapiJobsRequeueResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				jobIds: []string{"1"},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP Error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045PostJobsRequeueWithResponse: func(ctx context.Context, body api.SlurmV0045PostJobsRequeueJSONRequestBody, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045PostJobsRequeueResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				jobIds: []string{"1"},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			got, err := c.RequeueJobInfos(tt.args.ctx, tt.args.jobIds, tt.args.flags)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.RequeueJobInfos() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			require.Equal(t, tt.want, got)
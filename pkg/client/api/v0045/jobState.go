@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package v0045
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	apierrors "github.com/SlinkyProject/slurm-client/pkg/errors"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+type JobStateInterface interface {
+	GetJobState(ctx context.Context, jobId string) (*types.V0045JobState, error)
+	ListJobState(ctx context.Context, params any) (*types.V0045JobStateList, error)
+}
+
+var _ JobStateInterface = &SlurmClient{}
+
+// GetJobState implements ClientInterface
+func (c *SlurmClient) GetJobState(ctx context.Context, jobId string) (*types.V0045JobState, error) {
+	params := &api.SlurmV0045GetJobsStateParams{JobId: &jobId}
+	list, err := c.ListJobState(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range list.Items {
+		if string(item.GetKey()) == jobId {
+			return &item, nil
+		}
+	}
+	return nil, apierrors.ErrObjectNotFound
+}
+
+// ListJobState implements ClientInterface
+func (c *SlurmClient) ListJobState(ctx context.Context, params any) (*types.V0045JobStateList, error) {
+	p := &api.SlurmV0045GetJobsStateParams{}
+	switch r := params.(type) {
+	case nil:
+	case api.SlurmV0045GetJobsStateParams:
+		p = &r
+	case *api.SlurmV0045GetJobsStateParams:
+		p = r
+	default:
+		return nil, errors.New("expected params to be SlurmV0045GetJobsStateParams")
+	}
+
+	res, err := c.SlurmV0045GetJobsStateWithResponse(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return nil, newSlurmError(res.StatusCode(), errs)
+	}
+
+	list := &types.V0045JobStateList{
+		Items: make([]types.V0045JobState, len(res.JSON200.Jobs)),
+	}
+	for i, item := range res.JSON200.Jobs {
+		utils.RemarshalOrDie(item, &list.Items[i])
+	}
+	return list, nil
+}
@@ -0,0 +1,304 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package v0045
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/client/api/v0045/fake"
+	"github.com/SlinkyProject/slurm-client/pkg/client/api/v0045/interceptor"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+)
+
+func TestSlurmClient_GetJobState(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx   context.Context
+		jobId string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *types.V0045JobState
+		wantErr bool
+	}{
+		{
+			name: "Not Found",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				jobId: "1",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Found",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetJobsStateWithResponse: func(ctx context.Context, params *api.SlurmV0045GetJobsStateParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetJobsStateResponse, error) {
+							res := &api.SlurmV0045GetJobsStateResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiJobInfoResp{
+									Jobs: api.V0045JobInfoMsg{
+										{JobId: ptr.To[int32](1)},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				jobId: "1",
+			},
+			want: &types.V0045JobState{
+				JobId: ptr.To[int32](1),
+			},
+			wantErr: false,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetJobsStateWithResponse: func(ctx context.Context, params *api.SlurmV0045GetJobsStateParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetJobsStateResponse, error) {
+							res := &api.SlurmV0045GetJobsStateResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0045OpenapiJobInfoResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				jobId: "1",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP Error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetJobsStateWithResponse: func(ctx context.Context, params *api.SlurmV0045GetJobsStateParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetJobsStateResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				jobId: "1",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			got, err := c.GetJobState(tt.args.ctx, tt.args.jobId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.GetJobState() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSlurmClient_ListJobState(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx    context.Context
+		params any
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *types.V0045JobStateList
+		wantErr bool
+	}{
+		{
+			name: "Empty list",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: &types.V0045JobStateList{
+				Items: make([]types.V0045JobState, 0),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Non-empty list",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetJobsStateWithResponse: func(ctx context.Context, params *api.SlurmV0045GetJobsStateParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetJobsStateResponse, error) {
+							res := &api.SlurmV0045GetJobsStateResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiJobInfoResp{
+									Jobs: api.V0045JobInfoMsg{
+										{JobId: ptr.To[int32](1)},
+										{JobId: ptr.To[int32](2)},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: &types.V0045JobStateList{
+				Items: []types.V0045JobState{
+					{JobId: ptr.To[int32](1)},
+					{JobId: ptr.To[int32](2)},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "With params",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetJobsStateWithResponse: func(ctx context.Context, params *api.SlurmV0045GetJobsStateParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetJobsStateResponse, error) {
+							if ptr.Deref(params.JobId, "") != "1,2" {
+								return nil, errors.New("unexpected params")
+							}
+							res := &api.SlurmV0045GetJobsStateResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiJobInfoResp{
+									Jobs: api.V0045JobInfoMsg{
+										{JobId: ptr.To[int32](1)},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: api.SlurmV0045GetJobsStateParams{JobId: ptr.To("1,2")},
+			},
+			want: &types.V0045JobStateList{
+				Items: []types.V0045JobState{
+					{JobId: ptr.To[int32](1)},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Bad params",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: "1,2",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetJobsStateWithResponse: func(ctx context.Context, params *api.SlurmV0045GetJobsStateParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetJobsStateResponse, error) {
+							res := &api.SlurmV0045GetJobsStateResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0045OpenapiJobInfoResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetJobsStateWithResponse: func(ctx context.Context, params *api.SlurmV0045GetJobsStateParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetJobsStateResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			got, err := c.ListJobState(tt.args.ctx, tt.args.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.ListJobState() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
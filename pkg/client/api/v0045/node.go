@@ -18,6 +18,7 @@ type NodeInterface interface {
 	CreateNewNode(ctx context.Context, req any) (*string, error)
 	DeleteNode(ctx context.Context, nodeName string) error
 	UpdateNode(ctx context.Context, nodeName string, req any) error
+	UpdateNodes(ctx context.Context, nodeNames []string, req any) error
 	GetNode(ctx context.Context, nodeName string) (*types.V0045Node, error)
 	ListNodes(ctx context.Context) (*types.V0045NodeList, error)
 }
@@ -37,11 +38,11 @@ func (c *SlurmClient) CreateNewNode(ctx context.Context, req any) (*string, erro
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0045OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return nil, errors.Join(errs...)
+		return nil, newSlurmError(res.StatusCode(), errs)
 	}
 
 	nodeName, err := utils.ParseNodeName(r.NodeConf)
@@ -60,11 +61,11 @@ func (c *SlurmClient) DeleteNode(ctx context.Context, nodeName string) error {
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0045OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return errors.Join(errs...)
+		return newSlurmError(res.StatusCode(), errs)
 	}
 
 	return nil
@@ -83,11 +84,38 @@ func (c *SlurmClient) UpdateNode(ctx context.Context, nodeName string, req any)
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0045OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return errors.Join(errs...)
+		return newSlurmError(res.StatusCode(), errs)
+	}
+
+	return nil
+}
+
+// UpdateNodes implements ClientInterface
+func (c *SlurmClient) UpdateNodes(ctx context.Context, nodeNames []string, req any) error {
+	r, ok := req.(api.V0045UpdateNodeMsg)
+	if !ok {
+		return errors.New("expected req to be V0045UpdateNodeMsg")
+	}
+	if len(nodeNames) == 0 {
+		return errors.New("expected at least one node name")
+	}
+	body := api.SlurmV0045PostNodesJSONRequestBody(r)
+	body.Name = &nodeNames
+	res, err := c.SlurmV0045PostNodesWithResponse(ctx, body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return newSlurmError(res.StatusCode(), errs)
 	}
 
 	return nil
@@ -102,11 +130,11 @@ func (c *SlurmClient) GetNode(ctx context.Context, nodeName string) (*types.V004
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0045OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return nil, errors.Join(errs...)
+		return nil, newSlurmError(res.StatusCode(), errs)
 	}
 
 	if len(res.JSON200.Nodes) == 0 {
@@ -127,11 +155,11 @@ func (c *SlurmClient) ListNodes(ctx context.Context) (*types.V0045NodeList, erro
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0045OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return nil, errors.Join(errs...)
+		return nil, newSlurmError(res.StatusCode(), errs)
 	}
 
 	list := &types.V0045NodeList{
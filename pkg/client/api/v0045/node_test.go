@@ -301,6 +301,131 @@ func TestSlurmClient_UpdateNode(t *testing.T) {
 	}
 }
 
+func TestSlurmClient_UpdateNodes(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx       context.Context
+		nodeNames []string
+		req       any
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Update existing nodes",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045PostNodesWithResponse: func(ctx context.Context, body api.V0045UpdateNodeMsg, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045PostNodesResponse, error) {
+							if body.Name == nil || len(*body.Name) != 2 {
+								return nil, errors.New("expected node names in request body")
+							}
+							res := &api.SlurmV0045PostNodesResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200:      &api.V0045OpenapiResp{},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:       context.Background(),
+				nodeNames: []string{"node-[0-1]", "node-3"},
+				req:       api.V0045UpdateNodeMsg{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "No node names",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx:       context.Background(),
+				nodeNames: nil,
+				req:       api.V0045UpdateNodeMsg{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Bad request",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx:       context.Background(),
+				nodeNames: []string{"node-0"},
+				req:       nil,
+			},
+			wantErr: true,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045PostNodesWithResponse: func(ctx context.Context, body api.V0045UpdateNodeMsg, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045PostNodesResponse, error) {
+							res := &api.SlurmV0045PostNodesResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0045OpenapiResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:       context.Background(),
+				nodeNames: []string{"node-0"},
+				req:       api.V0045UpdateNodeMsg{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "HTTP Error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045PostNodesWithResponse: func(ctx context.Context, body api.V0045UpdateNodeMsg, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045PostNodesResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:       context.Background(),
+				nodeNames: []string{"node-0"},
+				req:       api.V0045UpdateNodeMsg{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			if err := c.UpdateNodes(tt.args.ctx, tt.args.nodeNames, tt.args.req); (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.UpdateNodes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestSlurmClient_GetNode(t *testing.T) {
 	type fields struct {
 		ClientWithResponsesInterface api.ClientWithResponsesInterface
@@ -8,6 +8,8 @@ import (
 	"errors"
 	"net/http"
 
+	"k8s.io/utils/ptr"
+
 	api "github.com/SlinkyProject/slurm-client/api/v0045"
 	apierrors "github.com/SlinkyProject/slurm-client/pkg/errors"
 	"github.com/SlinkyProject/slurm-client/pkg/types"
@@ -15,12 +17,92 @@ import (
 )
 
 type PartitionInterface interface {
+	CreatePartitionInfo(ctx context.Context, req any) (string, error)
+	UpdatePartitionInfo(ctx context.Context, name string, req any) error
+	DeletePartitionInfo(ctx context.Context, name string) error
 	GetPartitionInfo(ctx context.Context, name string) (*types.V0045PartitionInfo, error)
 	ListPartitionInfo(ctx context.Context) (*types.V0045PartitionInfoList, error)
 }
 
 var _ PartitionInterface = &SlurmClient{}
 
+// CreatePartitionInfo implements ClientInterface
+func (c *SlurmClient) CreatePartitionInfo(ctx context.Context, req any) (string, error) {
+	r, ok := req.(api.V0045PartitionInfo)
+	if !ok {
+		return "", errors.New("expected req to be V0045PartitionInfo")
+	}
+	name := ptr.Deref(r.Name, "")
+	if name == "" {
+		return "", errors.New("expected partition name")
+	}
+
+	body := api.SlurmV0045PostPartitionsJSONRequestBody{
+		Partitions: api.V0045UpdatePartitionMsgList{r},
+	}
+	res, err := c.SlurmV0045PostPartitionsWithResponse(ctx, body)
+	if err != nil {
+		return "", err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return "", newSlurmError(res.StatusCode(), errs)
+	}
+
+	return name, nil
+}
+
+// UpdatePartitionInfo implements ClientInterface
+func (c *SlurmClient) UpdatePartitionInfo(ctx context.Context, name string, req any) error {
+	r, ok := req.(api.V0045PartitionInfo)
+	if !ok {
+		return errors.New("expected req to be V0045PartitionInfo")
+	}
+
+	// endpoint does not use ID parameter, but make it uniform with the rest that do
+	r.Name = &name
+
+	body := api.SlurmV0045PostPartitionsJSONRequestBody{
+		Partitions: api.V0045UpdatePartitionMsgList{r},
+	}
+	res, err := c.SlurmV0045PostPartitionsWithResponse(ctx, body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return newSlurmError(res.StatusCode(), errs)
+	}
+
+	return nil
+}
+
+// DeletePartitionInfo implements ClientInterface
+func (c *SlurmClient) DeletePartitionInfo(ctx context.Context, name string) error {
+	res, err := c.SlurmV0045DeletePartitionWithResponse(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return newSlurmError(res.StatusCode(), errs)
+	}
+
+	return nil
+}
+
 // GetPartitionInfo implements ClientInterface
 func (c *SlurmClient) GetPartitionInfo(ctx context.Context, name string) (*types.V0045PartitionInfo, error) {
 	params := &api.SlurmV0045GetPartitionParams{}
@@ -30,11 +112,11 @@ func (c *SlurmClient) GetPartitionInfo(ctx context.Context, name string) (*types
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0045OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return nil, errors.Join(errs...)
+		return nil, newSlurmError(res.StatusCode(), errs)
 	}
 
 	if len(res.JSON200.Partitions) == 0 {
@@ -55,11 +137,11 @@ func (c *SlurmClient) ListPartitionInfo(ctx context.Context) (*types.V0045Partit
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		errs := []error{errors.New(http.StatusText(res.StatusCode()))}
+		var errs *api.V0045OpenapiErrors
 		if res.JSONDefault != nil {
-			errs = append(errs, getOpenapiErrors(res.JSONDefault.Errors)...)
+			errs = res.JSONDefault.Errors
 		}
-		return nil, errors.Join(errs...)
+		return nil, newSlurmError(res.StatusCode(), errs)
 	}
 
 	list := &types.V0045PartitionInfoList{
@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package v0045
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	apierrors "github.com/SlinkyProject/slurm-client/pkg/errors"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+type QosInterface interface {
+	CreateQos(ctx context.Context, req any) (string, error)
+	DeleteQos(ctx context.Context, name string) error
+	UpdateQos(ctx context.Context, name string, req any) error
+	GetQos(ctx context.Context, name string, params any) (*types.V0045Qos, error)
+	ListQos(ctx context.Context, params any) (*types.V0045QosList, error)
+}
+
+var _ QosInterface = &SlurmClient{}
+
+// CreateQos implements ClientInterface
+func (c *SlurmClient) CreateQos(ctx context.Context, req any) (string, error) {
+	r, ok := req.(api.V0045Qos)
+	if !ok {
+		return "", errors.New("expected req to be V0045Qos")
+	}
+
+	if err := c.postQos(ctx, r); err != nil {
+		return "", err
+	}
+
+	return ptr.Deref(r.Name, ""), nil
+}
+
+// DeleteQos implements ClientInterface
+func (c *SlurmClient) DeleteQos(ctx context.Context, name string) error {
+	res, err := c.SlurmdbV0045DeleteSingleQosWithResponse(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return newSlurmError(res.StatusCode(), errs)
+	}
+
+	if len(res.JSON200.RemovedQos) == 0 {
+		return apierrors.ErrObjectNotFound
+	}
+
+	return nil
+}
+
+// UpdateQos implements ClientInterface
+func (c *SlurmClient) UpdateQos(ctx context.Context, name string, req any) error {
+	r, ok := req.(api.V0045Qos)
+	if !ok {
+		return errors.New("expected req to be V0045Qos")
+	}
+
+	// endpoint does not use ID parameter, but make it uniform with the rest that do
+	r.Name = ptr.To(name)
+
+	return c.postQos(ctx, r)
+}
+
+func (c *SlurmClient) postQos(ctx context.Context, qos api.V0045Qos) error {
+	body := api.SlurmdbV0045PostQosJSONRequestBody{
+		Qos: api.V0045QosList{qos},
+	}
+	res, err := c.SlurmdbV0045PostQosWithResponse(ctx, nil, body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return newSlurmError(res.StatusCode(), errs)
+	}
+
+	return nil
+}
+
+// GetQos implements ClientInterface
+func (c *SlurmClient) GetQos(ctx context.Context, name string, params any) (*types.V0045Qos, error) {
+	p := &api.SlurmdbV0045GetSingleQosParams{}
+	switch r := params.(type) {
+	case nil:
+	case api.SlurmdbV0045GetSingleQosParams:
+		p = &r
+	case *api.SlurmdbV0045GetSingleQosParams:
+		p = r
+	default:
+		return nil, errors.New("expected params to be SlurmdbV0045GetSingleQosParams")
+	}
+
+	res, err := c.SlurmdbV0045GetSingleQosWithResponse(ctx, name, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return nil, newSlurmError(res.StatusCode(), errs)
+	}
+
+	if len(res.JSON200.Qos) == 0 {
+		return nil, apierrors.ErrObjectNotFound
+	}
+
+	out := &types.V0045Qos{}
+	utils.RemarshalOrDie(res.JSON200.Qos[0], out)
+	return out, nil
+}
+
+// ListQos implements ClientInterface
+func (c *SlurmClient) ListQos(ctx context.Context, params any) (*types.V0045QosList, error) {
+	p := &api.SlurmdbV0045GetQosParams{}
+	switch r := params.(type) {
+	case nil:
+	case api.SlurmdbV0045GetQosParams:
+		p = &r
+	case *api.SlurmdbV0045GetQosParams:
+		p = r
+	default:
+		return nil, errors.New("expected params to be SlurmdbV0045GetQosParams")
+	}
+
+	res, err := c.SlurmdbV0045GetQosWithResponse(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return nil, newSlurmError(res.StatusCode(), errs)
+	}
+
+	list := &types.V0045QosList{
+		Items: make([]types.V0045Qos, len(res.JSON200.Qos)),
+	}
+	for i, item := range res.JSON200.Qos {
+		utils.RemarshalOrDie(item, &list.Items[i])
+	}
+	return list, nil
+}
@@ -14,8 +14,10 @@ import (
 	"k8s.io/utils/ptr"
 
 	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	clientapi "github.com/SlinkyProject/slurm-client/pkg/client/api"
 	"github.com/SlinkyProject/slurm-client/pkg/client/api/v0045/fake"
 	"github.com/SlinkyProject/slurm-client/pkg/client/api/v0045/interceptor"
+	apierrors "github.com/SlinkyProject/slurm-client/pkg/errors"
 	"github.com/SlinkyProject/slurm-client/pkg/types"
 )
 
@@ -623,3 +625,135 @@ func TestSlurmClient_UpdateReservationInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestSlurmClient_CreateOrUpdateReservationInfos(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx context.Context
+		req any
+	}
+	req := api.V0045ReservationModReq{
+		Reservations: &api.V0045ReservationDescMsgList{
+			{Name: ptr.To("foo")},
+			{Name: ptr.To("bar")},
+		},
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []clientapi.ReservationResult
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045PostReservationsWithResponse: func(ctx context.Context, body api.V0045ReservationModReq, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045PostReservationsResponse, error) {
+							res := &api.SlurmV0045PostReservationsResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiReservationModResp{
+									Reservations: *body.Reservations,
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			want: []clientapi.ReservationResult{
+				{Name: "foo"},
+				{Name: "bar"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Bad request",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx: context.Background(),
+				req: api.V0045ReservationDescMsg{},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045PostReservationsWithResponse: func(ctx context.Context, body api.V0045ReservationModReq, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045PostReservationsResponse, error) {
+							res := &api.SlurmV0045PostReservationsResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0045OpenapiReservationModResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+									},
+									Reservations: api.V0045ReservationDescMsgList{
+										{Name: ptr.To("foo")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			want: []clientapi.ReservationResult{
+				{Name: "foo"},
+				{Name: "bar", Error: &apierrors.SlurmError{
+					StatusCode: http.StatusInternalServerError,
+					Errors:     []apierrors.SlurmErrorEntry{{Error: "error 1"}},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "HTTP Error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045PostReservationsWithResponse: func(ctx context.Context, body api.V0045ReservationModReq, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045PostReservationsResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			got, err := c.CreateOrUpdateReservationInfos(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.CreateOrUpdateReservationInfos() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package v0045
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	apierrors "github.com/SlinkyProject/slurm-client/pkg/errors"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+type SharesInterface interface {
+	GetShares(ctx context.Context, assocId string) (*types.V0045Shares, error)
+	ListShares(ctx context.Context, params any) (*types.V0045SharesList, error)
+}
+
+var _ SharesInterface = &SlurmClient{}
+
+// GetShares implements ClientInterface
+func (c *SlurmClient) GetShares(ctx context.Context, assocId string) (*types.V0045Shares, error) {
+	list, err := c.ListShares(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range list.Items {
+		if string(item.GetKey()) == assocId {
+			return &item, nil
+		}
+	}
+	return nil, apierrors.ErrObjectNotFound
+}
+
+// ListShares implements ClientInterface
+func (c *SlurmClient) ListShares(ctx context.Context, params any) (*types.V0045SharesList, error) {
+	p := &api.SlurmV0045GetSharesParams{}
+	switch r := params.(type) {
+	case nil:
+	case api.SlurmV0045GetSharesParams:
+		p = &r
+	case *api.SlurmV0045GetSharesParams:
+		p = r
+	default:
+		return nil, errors.New("expected params to be SlurmV0045GetSharesParams")
+	}
+
+	res, err := c.SlurmV0045GetSharesWithResponse(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return nil, newSlurmError(res.StatusCode(), errs)
+	}
+
+	shares := []api.V0045AssocSharesObjWrap{}
+	if res.JSON200.Shares.Shares != nil {
+		shares = *res.JSON200.Shares.Shares
+	}
+	list := &types.V0045SharesList{
+		Items: make([]types.V0045Shares, len(shares)),
+	}
+	for i, item := range shares {
+		utils.RemarshalOrDie(item, &list.Items[i])
+	}
+	return list, nil
+}
@@ -0,0 +1,312 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package v0045
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/client/api/v0045/fake"
+	"github.com/SlinkyProject/slurm-client/pkg/client/api/v0045/interceptor"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+)
+
+func TestSlurmClient_GetShares(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx     context.Context
+		assocId string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *types.V0045Shares
+		wantErr bool
+	}{
+		{
+			name: "Not Found",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx:     context.Background(),
+				assocId: "1",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Found",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetSharesWithResponse: func(ctx context.Context, params *api.SlurmV0045GetSharesParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetSharesResponse, error) {
+							res := &api.SlurmV0045GetSharesResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiSharesResp{
+									Shares: api.V0045SharesRespMsg{
+										Shares: &api.V0045AssocSharesObjList{
+											{Id: ptr.To[int32](1)},
+										},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:     context.Background(),
+				assocId: "1",
+			},
+			want: &types.V0045Shares{
+				V0045AssocSharesObjWrap: api.V0045AssocSharesObjWrap{
+					Id: ptr.To[int32](1),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetSharesWithResponse: func(ctx context.Context, params *api.SlurmV0045GetSharesParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetSharesResponse, error) {
+							res := &api.SlurmV0045GetSharesResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0045OpenapiSharesResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:     context.Background(),
+				assocId: "1",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP Error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetSharesWithResponse: func(ctx context.Context, params *api.SlurmV0045GetSharesParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetSharesResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:     context.Background(),
+				assocId: "1",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			got, err := c.GetShares(tt.args.ctx, tt.args.assocId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.GetShares() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSlurmClient_ListShares(t *testing.T) {
+	type fields struct {
+		ClientWithResponsesInterface api.ClientWithResponsesInterface
+	}
+	type args struct {
+		ctx    context.Context
+		params any
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *types.V0045SharesList
+		wantErr bool
+	}{
+		{
+			name: "Empty list",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: &types.V0045SharesList{
+				Items: make([]types.V0045Shares, 0),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Non-empty list",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetSharesWithResponse: func(ctx context.Context, params *api.SlurmV0045GetSharesParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetSharesResponse, error) {
+							res := &api.SlurmV0045GetSharesResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiSharesResp{
+									Shares: api.V0045SharesRespMsg{
+										Shares: &api.V0045AssocSharesObjList{
+											{Id: ptr.To[int32](1)},
+											{Id: ptr.To[int32](2)},
+										},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: &types.V0045SharesList{
+				Items: []types.V0045Shares{
+					{V0045AssocSharesObjWrap: api.V0045AssocSharesObjWrap{Id: ptr.To[int32](1)}},
+					{V0045AssocSharesObjWrap: api.V0045AssocSharesObjWrap{Id: ptr.To[int32](2)}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "With params",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetSharesWithResponse: func(ctx context.Context, params *api.SlurmV0045GetSharesParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetSharesResponse, error) {
+							if ptr.Deref(params.Accounts, "") != "physics" {
+								return nil, errors.New("unexpected params")
+							}
+							res := &api.SlurmV0045GetSharesResponse{
+								HTTPResponse: &fake.HttpSuccess,
+								JSON200: &api.V0045OpenapiSharesResp{
+									Shares: api.V0045SharesRespMsg{
+										Shares: &api.V0045AssocSharesObjList{
+											{Id: ptr.To[int32](1)},
+										},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: api.SlurmV0045GetSharesParams{Accounts: ptr.To("physics")},
+			},
+			want: &types.V0045SharesList{
+				Items: []types.V0045Shares{
+					{V0045AssocSharesObjWrap: api.V0045AssocSharesObjWrap{Id: ptr.To[int32](1)}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Bad params",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClient(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: "physics",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP Status != 200",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetSharesWithResponse: func(ctx context.Context, params *api.SlurmV0045GetSharesParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetSharesResponse, error) {
+							res := &api.SlurmV0045GetSharesResponse{
+								HTTPResponse: &http.Response{
+									Status:     http.StatusText(http.StatusInternalServerError),
+									StatusCode: http.StatusInternalServerError,
+								},
+								JSONDefault: &api.V0045OpenapiSharesResp{
+									Errors: &[]api.V0045OpenapiError{
+										{Error: ptr.To("error 1")},
+										{Error: ptr.To("error 2")},
+									},
+								},
+							}
+							return res, nil
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "HTTP error",
+			fields: fields{
+				ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+					WithInterceptorFuncs(interceptor.Funcs{
+						SlurmV0045GetSharesWithResponse: func(ctx context.Context, params *api.SlurmV0045GetSharesParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetSharesResponse, error) {
+							return nil, errors.New(http.StatusText(http.StatusBadGateway))
+						},
+					}).
+					Build(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &SlurmClient{
+				ClientWithResponsesInterface: tt.fields.ClientWithResponsesInterface,
+			}
+			got, err := c.ListShares(tt.args.ctx, tt.args.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SlurmClient.ListShares() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package v0045
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	apierrors "github.com/SlinkyProject/slurm-client/pkg/errors"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+type TresInterface interface {
+	CreateTres(ctx context.Context, req any) (string, error)
+	GetTres(ctx context.Context, key string) (*types.V0045Tres, error)
+	ListTres(ctx context.Context) (*types.V0045TresList, error)
+}
+
+var _ TresInterface = &SlurmClient{}
+
+// CreateTres implements ClientInterface
+func (c *SlurmClient) CreateTres(ctx context.Context, req any) (string, error) {
+	r, ok := req.(api.V0045Tres)
+	if !ok {
+		return "", errors.New("expected req to be V0045Tres")
+	}
+
+	body := api.SlurmdbV0045PostTresJSONRequestBody{
+		TRES: api.V0045TresList{r},
+	}
+	res, err := c.SlurmdbV0045PostTresWithResponse(ctx, body)
+	if err != nil {
+		return "", err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return "", newSlurmError(res.StatusCode(), errs)
+	}
+
+	tres := &types.V0045Tres{V0045Tres: r}
+	return string(tres.GetKey()), nil
+}
+
+// GetTres implements ClientInterface
+func (c *SlurmClient) GetTres(ctx context.Context, key string) (*types.V0045Tres, error) {
+	list, err := c.ListTres(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range list.Items {
+		if string(item.GetKey()) == key {
+			return &item, nil
+		}
+	}
+	return nil, apierrors.ErrObjectNotFound
+}
+
+// ListTres implements ClientInterface
+func (c *SlurmClient) ListTres(ctx context.Context) (*types.V0045TresList, error) {
+	res, err := c.SlurmdbV0045GetTresWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	} else if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return nil, newSlurmError(res.StatusCode(), errs)
+	}
+	list := &types.V0045TresList{
+		Items: make([]types.V0045Tres, len(res.JSON200.TRES)),
+	}
+	for i, item := range res.JSON200.TRES {
+		utils.RemarshalOrDie(item, &list.Items[i])
+	}
+	return list, nil
+}
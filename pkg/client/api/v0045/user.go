@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package v0045
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	apierrors "github.com/SlinkyProject/slurm-client/pkg/errors"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+type UserInterface interface {
+	CreateUser(ctx context.Context, req any) (string, error)
+	DeleteUser(ctx context.Context, name string) error
+	UpdateUser(ctx context.Context, name string, req any) error
+	GetUser(ctx context.Context, name string, params any) (*types.V0045User, error)
+	ListUsers(ctx context.Context, params any) (*types.V0045UserList, error)
+	CreateUserAssociation(ctx context.Context, req any) ([]string, error)
+}
+
+var _ UserInterface = &SlurmClient{}
+
+// CreateUser implements ClientInterface
+func (c *SlurmClient) CreateUser(ctx context.Context, req any) (string, error) {
+	r, ok := req.(api.V0045User)
+	if !ok {
+		return "", errors.New("expected req to be V0045User")
+	}
+
+	if err := c.postUsers(ctx, r); err != nil {
+		return "", err
+	}
+
+	return r.Name, nil
+}
+
+// DeleteUser implements ClientInterface
+func (c *SlurmClient) DeleteUser(ctx context.Context, name string) error {
+	res, err := c.SlurmdbV0045DeleteUserWithResponse(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		err := newSlurmError(res.StatusCode(), errs)
+		// The delete response does not list the removed users, Slurm reports
+		// an empty result when there was no user to remove.
+		if apierrors.HasErrno(err, apierrors.ESLURM_REST_EMPTY_RESULT) {
+			return apierrors.ErrObjectNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+// UpdateUser implements ClientInterface
+func (c *SlurmClient) UpdateUser(ctx context.Context, name string, req any) error {
+	r, ok := req.(api.V0045User)
+	if !ok {
+		return errors.New("expected req to be V0045User")
+	}
+
+	// endpoint does not use ID parameter, but make it uniform with the rest that do
+	r.Name = name
+
+	return c.postUsers(ctx, r)
+}
+
+func (c *SlurmClient) postUsers(ctx context.Context, user api.V0045User) error {
+	body := api.SlurmdbV0045PostUsersJSONRequestBody{
+		Users: api.V0045UserList{user},
+	}
+	res, err := c.SlurmdbV0045PostUsersWithResponse(ctx, body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return newSlurmError(res.StatusCode(), errs)
+	}
+
+	return nil
+}
+
+// GetUser implements ClientInterface
+func (c *SlurmClient) GetUser(ctx context.Context, name string, params any) (*types.V0045User, error) {
+	p := &api.SlurmdbV0045GetUserParams{}
+	switch r := params.(type) {
+	case nil:
+	case api.SlurmdbV0045GetUserParams:
+		p = &r
+	case *api.SlurmdbV0045GetUserParams:
+		p = r
+	default:
+		return nil, errors.New("expected params to be SlurmdbV0045GetUserParams")
+	}
+
+	res, err := c.SlurmdbV0045GetUserWithResponse(ctx, name, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return nil, newSlurmError(res.StatusCode(), errs)
+	}
+
+	if len(res.JSON200.Users) == 0 {
+		return nil, apierrors.ErrObjectNotFound
+	}
+
+	out := &types.V0045User{}
+	utils.RemarshalOrDie(res.JSON200.Users[0], out)
+	return out, nil
+}
+
+// ListUsers implements ClientInterface
+func (c *SlurmClient) ListUsers(ctx context.Context, params any) (*types.V0045UserList, error) {
+	p := &api.SlurmdbV0045GetUsersParams{}
+	switch r := params.(type) {
+	case nil:
+	case api.SlurmdbV0045GetUsersParams:
+		p = &r
+	case *api.SlurmdbV0045GetUsersParams:
+		p = r
+	default:
+		return nil, errors.New("expected params to be SlurmdbV0045GetUsersParams")
+	}
+
+	res, err := c.SlurmdbV0045GetUsersWithResponse(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return nil, newSlurmError(res.StatusCode(), errs)
+	}
+
+	list := &types.V0045UserList{
+		Items: make([]types.V0045User, len(res.JSON200.Users)),
+	}
+	for i, item := range res.JSON200.Users {
+		utils.RemarshalOrDie(item, &list.Items[i])
+	}
+	return list, nil
+}
+
+// CreateUserAssociation implements ClientInterface
+func (c *SlurmClient) CreateUserAssociation(ctx context.Context, req any) ([]string, error) {
+	r, ok := req.(api.V0045OpenapiUsersAddCondResp)
+	if !ok {
+		return nil, errors.New("expected req to be V0045OpenapiUsersAddCondResp")
+	}
+	if len(r.AssociationCondition.Users) == 0 {
+		return nil, errors.New("expected at least one user in association condition")
+	}
+
+	body := api.SlurmdbV0045PostUsersAssociationJSONRequestBody(r)
+	res, err := c.SlurmdbV0045PostUsersAssociationWithResponse(ctx, nil, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return nil, newSlurmError(res.StatusCode(), errs)
+	}
+
+	return r.AssociationCondition.Users, nil
+}
@@ -5,7 +5,6 @@ package v0045
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 
@@ -13,6 +12,7 @@ import (
 
 	api "github.com/SlinkyProject/slurm-client/api/v0045"
 	clientapi "github.com/SlinkyProject/slurm-client/pkg/client/api"
+	apierrors "github.com/SlinkyProject/slurm-client/pkg/errors"
 )
 
 const (
@@ -25,14 +25,30 @@ const (
 
 type ClientInterface interface {
 	api.ClientWithResponsesInterface
+	AccountInterface
+	AccountingJobInterface
+	AssociationInterface
+	ClusterInterface
+	ConfInterface
 	ControllerPingInfoInterface
+	DbdConfigInterface
+	DbdPingInterface
+	DbdStatsInterface
+	InstanceInterface
 	JobInfoInterface
+	JobStateInterface
+	LicenseInterface
 	NodeInterface
 	NodeResourceLayoutInterface
 	PartitionInterface
+	QosInterface
 	ReconfigureInterface
 	ReservationInterface
+	SharesInterface
 	StatsInterface
+	TresInterface
+	UserInterface
+	WCKeyInterface
 }
 
 type SlurmClient struct {
@@ -79,12 +95,34 @@ func NewSlurmClient(server, token string, httpServer *http.Client, opts ...clien
 	return &SlurmClient{client}, nil
 }
 
-func getOpenapiErrors(oapierrors *api.V0045OpenapiErrors) []error {
-	errs := []error{}
+// newSlurmError returns a SlurmError for a response with statusCode and the
+// errors reported by Slurm.
+func newSlurmError(statusCode int, oapierrors *api.V0045OpenapiErrors) error {
+	errs := []apierrors.SlurmErrorEntry{}
 	for _, err := range ptr.Deref(oapierrors, []api.V0045OpenapiError{}) {
-		if err.Error != nil {
-			errs = append(errs, errors.New(*err.Error))
-		}
+		errs = append(errs, apierrors.SlurmErrorEntry{
+			ErrorNumber: apierrors.Errno(ptr.Deref(err.ErrorNumber, 0)),
+			Error:       ptr.Deref(err.Error, ""),
+			Description: ptr.Deref(err.Description, ""),
+			Source:      ptr.Deref(err.Source, ""),
+		})
+	}
+	return &apierrors.SlurmError{
+		StatusCode: statusCode,
+		Errors:     errs,
+	}
+}
+
+// newJobError returns a SlurmError for the error Slurm reported for a single
+// job of a successful request.
+func newJobError(errno int32, msg, why string) error {
+	if why != "" {
+		msg = fmt.Sprintf("%s: %s", msg, why)
+	}
+	return &apierrors.SlurmError{
+		Errors: []apierrors.SlurmErrorEntry{{
+			ErrorNumber: apierrors.Errno(errno),
+			Error:       msg,
+		}},
 	}
-	return errs
 }
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package v0045
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/client/api/v0045/fake"
+	"github.com/SlinkyProject/slurm-client/pkg/client/api/v0045/interceptor"
+	apierrors "github.com/SlinkyProject/slurm-client/pkg/errors"
+)
+
+func Test_newSlurmError(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		oapierrors *api.V0045OpenapiErrors
+		want       *apierrors.SlurmError
+	}{
+		{
+			name:       "No errors",
+			statusCode: http.StatusBadGateway,
+			want: &apierrors.SlurmError{
+				StatusCode: http.StatusBadGateway,
+				Errors:     []apierrors.SlurmErrorEntry{},
+			},
+		},
+		{
+			name:       "Errors",
+			statusCode: http.StatusInternalServerError,
+			oapierrors: &api.V0045OpenapiErrors{
+				{
+					ErrorNumber: ptr.To[int32](2017),
+					Error:       ptr.To("Invalid job id specified"),
+					Description: ptr.To("Unable to query JobId=1"),
+					Source:      ptr.To("_handle_job_get()"),
+				},
+				{
+					Error: ptr.To("error 2"),
+				},
+			},
+			want: &apierrors.SlurmError{
+				StatusCode: http.StatusInternalServerError,
+				Errors: []apierrors.SlurmErrorEntry{
+					{
+						ErrorNumber: apierrors.ESLURM_INVALID_JOB_ID,
+						Error:       "Invalid job id specified",
+						Description: "Unable to query JobId=1",
+						Source:      "_handle_job_get()",
+					},
+					{
+						Error: "error 2",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newSlurmError(tt.statusCode, tt.oapierrors)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSlurmClient_GetJobInfo_InvalidJobID(t *testing.T) {
+	c := &SlurmClient{
+		ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+			WithInterceptorFuncs(interceptor.Funcs{
+				SlurmV0045GetJobWithResponse: func(ctx context.Context, jobId string, params *api.SlurmV0045GetJobParams, reqEditors ...api.RequestEditorFn) (*api.SlurmV0045GetJobResponse, error) {
+					res := &api.SlurmV0045GetJobResponse{
+						HTTPResponse: &http.Response{
+							Status:     http.StatusText(http.StatusInternalServerError),
+							StatusCode: http.StatusInternalServerError,
+						},
+						JSONDefault: &api.V0045OpenapiJobInfoResp{
+							Errors: &[]api.V0045OpenapiError{
+								{
+									ErrorNumber: ptr.To(int32(apierrors.ESLURM_INVALID_JOB_ID)),
+									Error:       ptr.To("Invalid job id specified"),
+								},
+							},
+						},
+					}
+					return res, nil
+				},
+			}).
+			Build(),
+	}
+	_, err := c.GetJobInfo(context.Background(), "1")
+	require.ErrorIs(t, err, apierrors.ErrObjectNotFound)
+	require.True(t, apierrors.IsInvalidJobID(err))
+
+	var slurmErr *apierrors.SlurmError
+	require.True(t, errors.As(err, &slurmErr))
+	require.Equal(t, http.StatusInternalServerError, slurmErr.StatusCode)
+}
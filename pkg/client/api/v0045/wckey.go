@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package v0045
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	apierrors "github.com/SlinkyProject/slurm-client/pkg/errors"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+type WCKeyInterface interface {
+	CreateWCKey(ctx context.Context, req any) (string, error)
+	DeleteWCKey(ctx context.Context, id string) error
+	UpdateWCKey(ctx context.Context, id string, req any) error
+	GetWCKey(ctx context.Context, id string) (*types.V0045WCKey, error)
+	ListWCKeys(ctx context.Context, params any) (*types.V0045WCKeyList, error)
+}
+
+var _ WCKeyInterface = &SlurmClient{}
+
+// CreateWCKey implements ClientInterface
+func (c *SlurmClient) CreateWCKey(ctx context.Context, req any) (string, error) {
+	r, ok := req.(api.V0045Wckey)
+	if !ok {
+		return "", errors.New("expected req to be V0045Wckey")
+	}
+
+	if err := c.postWCKeys(ctx, r); err != nil {
+		return "", err
+	}
+
+	// The ID is assigned by slurmdbd, look it up by the identifying fields.
+	params := &api.SlurmdbV0045GetWckeysParams{
+		Cluster: ptr.To(r.Cluster),
+		User:    ptr.To(r.User),
+		Name:    ptr.To(r.Name),
+	}
+	list, err := c.ListWCKeys(ctx, params)
+	if err != nil {
+		return "", err
+	}
+	for _, item := range list.Items {
+		if item.Cluster == r.Cluster && item.User == r.User && item.Name == r.Name {
+			return string(item.GetKey()), nil
+		}
+	}
+
+	return "", apierrors.ErrObjectNotFound
+}
+
+// DeleteWCKey implements ClientInterface
+func (c *SlurmClient) DeleteWCKey(ctx context.Context, id string) error {
+	res, err := c.SlurmdbV0045DeleteWckeyWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return newSlurmError(res.StatusCode(), errs)
+	}
+
+	if len(res.JSON200.DeletedWckeys) == 0 {
+		return apierrors.ErrObjectNotFound
+	}
+
+	return nil
+}
+
+// UpdateWCKey implements ClientInterface
+func (c *SlurmClient) UpdateWCKey(ctx context.Context, id string, req any) error {
+	r, ok := req.(api.V0045Wckey)
+	if !ok {
+		return errors.New("expected req to be V0045Wckey")
+	}
+
+	wckeyId, err := strconv.ParseInt(id, 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid wckey id %q: %w", id, err)
+	}
+
+	// endpoint does not use ID parameter, so the identifying fields must be
+	// taken from the existing WCKey.
+	existing, err := c.GetWCKey(ctx, id)
+	if err != nil {
+		return err
+	}
+	r.Id = ptr.To(int32(wckeyId))
+	r.Cluster = existing.Cluster
+	r.User = existing.User
+	r.Name = existing.Name
+
+	return c.postWCKeys(ctx, r)
+}
+
+func (c *SlurmClient) postWCKeys(ctx context.Context, wckey api.V0045Wckey) error {
+	body := api.SlurmdbV0045PostWckeysJSONRequestBody{
+		Wckeys: api.V0045WckeyList{wckey},
+	}
+	res, err := c.SlurmdbV0045PostWckeysWithResponse(ctx, nil, body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return newSlurmError(res.StatusCode(), errs)
+	}
+
+	return nil
+}
+
+// GetWCKey implements ClientInterface
+func (c *SlurmClient) GetWCKey(ctx context.Context, id string) (*types.V0045WCKey, error) {
+	res, err := c.SlurmdbV0045GetWckeyWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return nil, newSlurmError(res.StatusCode(), errs)
+	}
+
+	if len(res.JSON200.Wckeys) == 0 {
+		return nil, apierrors.ErrObjectNotFound
+	}
+
+	out := &types.V0045WCKey{}
+	utils.RemarshalOrDie(res.JSON200.Wckeys[0], out)
+	return out, nil
+}
+
+// ListWCKeys implements ClientInterface
+func (c *SlurmClient) ListWCKeys(ctx context.Context, params any) (*types.V0045WCKeyList, error) {
+	p := &api.SlurmdbV0045GetWckeysParams{}
+	switch r := params.(type) {
+	case nil:
+	case api.SlurmdbV0045GetWckeysParams:
+		p = &r
+	case *api.SlurmdbV0045GetWckeysParams:
+		p = r
+	default:
+		return nil, errors.New("expected params to be SlurmdbV0045GetWckeysParams")
+	}
+
+	res, err := c.SlurmdbV0045GetWckeysWithResponse(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		var errs *api.V0045OpenapiErrors
+		if res.JSONDefault != nil {
+			errs = res.JSONDefault.Errors
+		}
+		return nil, newSlurmError(res.StatusCode(), errs)
+	}
+
+	list := &types.V0045WCKeyList{
+		Items: make([]types.V0045WCKey, len(res.JSON200.Wckeys)),
+	}
+	for i, item := range res.JSON200.Wckeys {
+		utils.RemarshalOrDie(item, &list.Items[i])
+	}
+	return list, nil
+}
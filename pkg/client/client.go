@@ -15,6 +15,7 @@ import (
 	"k8s.io/utils/ptr"
 	"k8s.io/utils/set"
 
+	v0045api "github.com/SlinkyProject/slurm-client/api/v0045"
 	clientapi "github.com/SlinkyProject/slurm-client/pkg/client/api"
 	v0042 "github.com/SlinkyProject/slurm-client/pkg/client/api/v0042"
 	v0043 "github.com/SlinkyProject/slurm-client/pkg/client/api/v0043"
@@ -24,6 +25,7 @@ import (
 	apierrors "github.com/SlinkyProject/slurm-client/pkg/errors"
 	"github.com/SlinkyProject/slurm-client/pkg/object"
 	"github.com/SlinkyProject/slurm-client/pkg/types"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
 )
 
 // Config holds the common attributes that can be passed to a Slurm client on
@@ -72,11 +74,17 @@ type client struct {
 
 	config Config
 
-	cacheSyncPeriod time.Duration
+	cacheSyncPeriod  time.Duration
+	jobStateSync     bool
+	discoverVersions bool
 
 	tokenMu       sync.RWMutex
 	authToken     string
 	tokenProvider *tokenProviderValue
+
+	versionsMu        sync.RWMutex
+	supportedVersions map[string][]string
+	versionsErr       error
 }
 
 // NewClient initializes a client.
@@ -89,6 +97,8 @@ func NewClient(config *Config, opts ...ClientOption) (Client, error) {
 	options := &ClientOptions{
 		CacheSyncPeriod: defaultSyncPeriod,
 		DisableFor: []object.Object{
+			&types.V0045AccountingJob{},
+			&types.V0045Instance{},
 			&types.V0045NodeResourceLayout{},
 			&types.V0045Reconfigure{},
 			&types.V0044NodeResourceLayout{},
@@ -101,10 +111,12 @@ func NewClient(config *Config, opts ...ClientOption) (Client, error) {
 
 	// create return client object
 	c := &client{
-		informers:       make(map[object.ObjectType]InformerCache),
-		uncached:        make(set.Set[object.ObjectType]),
-		config:          ptr.Deref(config, Config{}),
-		cacheSyncPeriod: options.CacheSyncPeriod,
+		informers:        make(map[object.ObjectType]InformerCache),
+		uncached:         make(set.Set[object.ObjectType]),
+		config:           ptr.Deref(config, Config{}),
+		cacheSyncPeriod:  options.CacheSyncPeriod,
+		jobStateSync:     options.JobStateSync,
+		discoverVersions: options.DiscoverVersions,
 	}
 	c.tokenProvider = &tokenProviderValue{Provider: c.config.TokenProvider}
 
@@ -114,6 +126,12 @@ func NewClient(config *Config, opts ...ClientOption) (Client, error) {
 		return nil, fmt.Errorf("unable to create client: %w", err)
 	}
 
+	if c.discoverVersions {
+		if err := c.DiscoverVersions(c.ctx); err != nil {
+			return nil, fmt.Errorf("unable to create client: %w", err)
+		}
+	}
+
 	for _, obj := range options.EnableFor {
 		c.GetInformer(obj.GetType())
 	}
@@ -166,6 +184,10 @@ func (c *client) Create(
 	options := &CreateOptions{}
 	options.ApplyOptions(opts)
 
+	if err := c.checkVersion(obj.GetType()); err != nil {
+		return err
+	}
+
 	var err error
 	var key object.ObjectKey
 	switch obj.(type) {
@@ -202,11 +224,40 @@ func (c *client) Create(
 
 	/////////////////////////////////////////////////////////////////////////////////
 
+	case *types.V0045Account:
+		var accountName string
+		accountName, err = c.v0045Client.CreateAccount(ctx, req)
+		key = object.ObjectKey(accountName)
+
+	case *types.V0045Association:
+		var associationKey string
+		associationKey, err = c.v0045Client.CreateAssociation(ctx, req)
+		key = object.ObjectKey(associationKey)
+
+	case *types.V0045Cluster:
+		var clusterName string
+		clusterName, err = c.v0045Client.CreateCluster(ctx, req)
+		key = object.ObjectKey(clusterName)
+
 	case *types.V0045JobInfo:
 		var jobId *int32
-		jobId, err = c.v0045Client.CreateJobInfo(ctx, req)
+		if options.Allocate {
+			jobId, err = c.v0045Client.AllocateJobInfo(ctx, req)
+		} else {
+			jobId, err = c.v0045Client.CreateJobInfo(ctx, req)
+		}
 		key = object.ObjectKey(fmt.Sprintf("%d", ptr.Deref(jobId, 0)))
 
+	case *types.V0045PartitionInfo:
+		var partitionName string
+		partitionName, err = c.v0045Client.CreatePartitionInfo(ctx, req)
+		key = object.ObjectKey(partitionName)
+
+	case *types.V0045Qos:
+		var qosName string
+		qosName, err = c.v0045Client.CreateQos(ctx, req)
+		key = object.ObjectKey(qosName)
+
 	case *types.V0045ReservationInfo:
 		var reservationName string
 		reservationName, err = c.v0045Client.CreateReservationInfo(ctx, req)
@@ -217,6 +268,21 @@ func (c *client) Create(
 		nodeName, err = c.v0045Client.CreateNewNode(ctx, req)
 		key = object.ObjectKey(ptr.Deref(nodeName, ""))
 
+	case *types.V0045Tres:
+		var tresKey string
+		tresKey, err = c.v0045Client.CreateTres(ctx, req)
+		key = object.ObjectKey(tresKey)
+
+	case *types.V0045User:
+		var userName string
+		userName, err = c.v0045Client.CreateUser(ctx, req)
+		key = object.ObjectKey(userName)
+
+	case *types.V0045WCKey:
+		var wckeyId string
+		wckeyId, err = c.v0045Client.CreateWCKey(ctx, req)
+		key = object.ObjectKey(wckeyId)
+
 	/////////////////////////////////////////////////////////////////////////////////
 
 	default:
@@ -240,6 +306,10 @@ func (c *client) Delete(
 	options := &DeleteOptions{}
 	options.ApplyOptions(opts)
 
+	if err := c.checkVersion(obj.GetType()); err != nil {
+		return err
+	}
+
 	var err error
 	key := string(obj.GetKey())
 	switch obj.(type) {
@@ -268,12 +338,26 @@ func (c *client) Delete(
 
 	/////////////////////////////////////////////////////////////////////////////////
 
+	case *types.V0045Account:
+		err = c.v0045Client.DeleteAccount(ctx, key)
+	case *types.V0045Association:
+		err = c.v0045Client.DeleteAssociation(ctx, key)
+	case *types.V0045Cluster:
+		err = c.v0045Client.DeleteCluster(ctx, key)
 	case *types.V0045JobInfo:
 		err = c.v0045Client.DeleteJobInfo(ctx, key)
 	case *types.V0045Node:
 		err = c.v0045Client.DeleteNode(ctx, key)
+	case *types.V0045PartitionInfo:
+		err = c.v0045Client.DeletePartitionInfo(ctx, key)
+	case *types.V0045Qos:
+		err = c.v0045Client.DeleteQos(ctx, key)
 	case *types.V0045ReservationInfo:
 		err = c.v0045Client.DeleteReservationInfo(ctx, key)
+	case *types.V0045User:
+		err = c.v0045Client.DeleteUser(ctx, key)
+	case *types.V0045WCKey:
+		err = c.v0045Client.DeleteWCKey(ctx, key)
 
 	/////////////////////////////////////////////////////////////////////////////////
 
@@ -309,6 +393,10 @@ func (c *client) Update(
 	options := &UpdateOptions{}
 	options.ApplyOptions(opts)
 
+	if err := c.checkVersion(obj.GetType()); err != nil {
+		return err
+	}
+
 	var err error
 	key := string(obj.GetKey())
 	switch obj.(type) {
@@ -337,12 +425,26 @@ func (c *client) Update(
 
 	/////////////////////////////////////////////////////////////////////////////////
 
+	case *types.V0045Account:
+		err = c.v0045Client.UpdateAccount(ctx, key, req)
+	case *types.V0045Association:
+		err = c.v0045Client.UpdateAssociation(ctx, key, req)
+	case *types.V0045Cluster:
+		err = c.v0045Client.UpdateCluster(ctx, key, req)
 	case *types.V0045JobInfo:
 		err = c.v0045Client.UpdateJobInfo(ctx, key, req)
 	case *types.V0045Node:
 		err = c.v0045Client.UpdateNode(ctx, key, req)
+	case *types.V0045PartitionInfo:
+		err = c.v0045Client.UpdatePartitionInfo(ctx, key, req)
+	case *types.V0045Qos:
+		err = c.v0045Client.UpdateQos(ctx, key, req)
 	case *types.V0045ReservationInfo:
 		err = c.v0045Client.UpdateReservationInfo(ctx, key, req)
+	case *types.V0045User:
+		err = c.v0045Client.UpdateUser(ctx, key, req)
+	case *types.V0045WCKey:
+		err = c.v0045Client.UpdateWCKey(ctx, key, req)
 
 	/////////////////////////////////////////////////////////////////////////////////
 
@@ -357,6 +459,369 @@ func (c *client) Update(
 	return c.Get(ctx, obj.GetKey(), obj, &GetOptions{RefreshCache: true})
 }
 
+// UpdateNodes implements Client.
+func (c *client) UpdateNodes(
+	ctx context.Context,
+	list object.ObjectList,
+	nodeNames []string,
+	req any,
+	opts ...UpdateOption,
+) error {
+	// Apply options
+	options := &UpdateOptions{}
+	options.ApplyOptions(opts)
+
+	if err := c.checkVersion(list.GetType()); err != nil {
+		return err
+	}
+
+	hosts := set.New[string]()
+	for _, nodeName := range nodeNames {
+		expanded, err := utils.ExpandHostlist(nodeName)
+		if err != nil {
+			return err
+		}
+		hosts.Insert(expanded...)
+	}
+
+	switch l := list.(type) {
+	/////////////////////////////////////////////////////////////////////////////////
+
+	case *types.V0045NodeList:
+		if err := c.v0045Client.UpdateNodes(ctx, nodeNames, req); err != nil {
+			return err
+		}
+		nodeList := &types.V0045NodeList{}
+		if err := c.List(ctx, nodeList, &ListOptions{RefreshCache: true}); err != nil {
+			return err
+		}
+		for _, node := range nodeList.Items {
+			if hosts.Has(string(node.GetKey())) {
+				l.Items = append(l.Items, node)
+			}
+		}
+
+	/////////////////////////////////////////////////////////////////////////////////
+
+	default:
+		return apierrors.ErrNotImplemented
+	}
+
+	return nil
+}
+
+// CreateOrUpdateReservations implements Client.
+func (c *client) CreateOrUpdateReservations(
+	ctx context.Context,
+	list object.ObjectList,
+	req any,
+	opts ...UpdateOption,
+) ([]ReservationResult, error) {
+	// Apply options
+	options := &UpdateOptions{}
+	options.ApplyOptions(opts)
+
+	if err := c.checkVersion(list.GetType()); err != nil {
+		return nil, err
+	}
+
+	var err error
+	var results []ReservationResult
+	var getObj func() object.Object
+	switch list.(type) {
+	/////////////////////////////////////////////////////////////////////////////////
+
+	case *types.V0044ReservationInfoList:
+		results, err = c.v0044Client.CreateOrUpdateReservationInfos(ctx, req)
+		getObj = func() object.Object { return &types.V0044ReservationInfo{} }
+
+	/////////////////////////////////////////////////////////////////////////////////
+
+	case *types.V0045ReservationInfoList:
+		results, err = c.v0045Client.CreateOrUpdateReservationInfos(ctx, req)
+		getObj = func() object.Object { return &types.V0045ReservationInfo{} }
+
+	/////////////////////////////////////////////////////////////////////////////////
+
+	default:
+		return nil, apierrors.ErrNotImplemented
+	}
+
+	for _, result := range results {
+		if result.Error != nil {
+			continue
+		}
+		obj := getObj()
+		key := object.ObjectKey(result.Name)
+		if err := c.Get(ctx, key, obj, &GetOptions{RefreshCache: true}); err != nil {
+			return results, err
+		}
+		list.AppendItem(obj)
+	}
+
+	return results, err
+}
+
+// RequeueJob implements Client.
+func (c *client) RequeueJob(
+	ctx context.Context,
+	obj object.Object,
+	opts ...RequeueOption,
+) ([]JobResult, error) {
+	// Apply options
+	options := &RequeueOptions{}
+	options.ApplyOptions(opts)
+
+	if err := c.checkVersion(obj.GetType()); err != nil {
+		return nil, err
+	}
+
+	var err error
+	var results []JobResult
+	key := string(obj.GetKey())
+	switch obj.(type) {
+	/////////////////////////////////////////////////////////////////////////////////
+
+	case *types.V0045JobInfo:
+		results, err = c.v0045Client.RequeueJobInfo(ctx, key, options.toFlags())
+
+	/////////////////////////////////////////////////////////////////////////////////
+
+	default:
+		return nil, apierrors.ErrNotImplemented
+	}
+
+	if err != nil {
+		return results, err
+	}
+
+	return results, c.Get(ctx, obj.GetKey(), obj, &GetOptions{RefreshCache: true})
+}
+
+// RequeueJobs implements Client.
+func (c *client) RequeueJobs(
+	ctx context.Context,
+	list object.ObjectList,
+	opts ...RequeueOption,
+) ([]JobResult, error) {
+	// Apply options
+	options := &RequeueOptions{}
+	options.ApplyOptions(opts)
+
+	if err := c.checkVersion(list.GetType()); err != nil {
+		return nil, err
+	}
+
+	var err error
+	var results []JobResult
+	items := list.GetItems()
+	keys := make([]string, len(items))
+	for i, item := range items {
+		keys[i] = string(item.GetKey())
+	}
+	switch l := list.(type) {
+	/////////////////////////////////////////////////////////////////////////////////
+
+	case *types.V0045JobInfoList:
+		results, err = c.v0045Client.RequeueJobInfos(ctx, keys, options.toFlags())
+		if err != nil {
+			return results, err
+		}
+		for i := range l.Items {
+			item := &l.Items[i]
+			err := c.Get(ctx, item.GetKey(), item, &GetOptions{RefreshCache: true})
+			if err != nil && !errors.Is(err, apierrors.ErrObjectNotFound) {
+				return results, err
+			}
+		}
+
+	/////////////////////////////////////////////////////////////////////////////////
+
+	default:
+		return nil, apierrors.ErrNotImplemented
+	}
+
+	return results, nil
+}
+
+// KillJobs implements Client.
+func (c *client) KillJobs(
+	ctx context.Context,
+	obj object.Object,
+	opts ...KillJobsOption,
+) ([]JobResult, error) {
+	// Apply options
+	options := &KillJobsOptions{}
+	options.ApplyOptions(opts)
+
+	if err := c.checkVersion(obj.GetType()); err != nil {
+		return nil, err
+	}
+
+	if !options.HasFilter() {
+		return nil, errors.New("at least one job filter must be set")
+	}
+
+	var err error
+	var results []JobResult
+	var list object.ObjectList
+	switch obj.(type) {
+	/////////////////////////////////////////////////////////////////////////////////
+
+	case *types.V0045JobInfo:
+		req := v0045api.V0045KillJobsMsg{
+			Signal:      utils.StringPtrOrNil(options.Signal),
+			Account:     utils.StringPtrOrNil(options.Account),
+			UserName:    utils.StringPtrOrNil(options.UserName),
+			Partition:   utils.StringPtrOrNil(options.Partition),
+			Qos:         utils.StringPtrOrNil(options.Qos),
+			Reservation: utils.StringPtrOrNil(options.Reservation),
+			JobName:     utils.StringPtrOrNil(options.JobName),
+		}
+		if len(options.JobIds) > 0 {
+			req.Jobs = ptr.To(options.JobIds)
+		}
+		if len(options.JobState) > 0 {
+			jobState := make([]v0045api.V0045KillJobsMsgJobState, len(options.JobState))
+			for i, state := range options.JobState {
+				jobState[i] = v0045api.V0045KillJobsMsgJobState(state)
+			}
+			req.JobState = &jobState
+		}
+		flags := []v0045api.V0045KillJobsMsgFlags{}
+		if options.Full {
+			flags = append(flags, v0045api.V0045KillJobsMsgFlagsFULLJOB)
+		}
+		if options.Batch {
+			flags = append(flags, v0045api.V0045KillJobsMsgFlagsBATCHJOB)
+		}
+		if options.Hurry {
+			flags = append(flags, v0045api.V0045KillJobsMsgFlagsHURRY)
+		}
+		if len(flags) > 0 {
+			req.Flags = &flags
+		}
+		results, err = c.v0045Client.DeleteJobInfos(ctx, req)
+		list = &types.V0045JobInfoList{}
+
+	/////////////////////////////////////////////////////////////////////////////////
+
+	default:
+		return nil, apierrors.ErrNotImplemented
+	}
+
+	if err != nil {
+		return results, err
+	}
+
+	return results, c.refreshInformer(ctx, list)
+}
+
+// EnsureUserInAccount implements Client.
+func (c *client) EnsureUserInAccount(
+	ctx context.Context,
+	obj object.Object,
+	userName, account string,
+	opts ...EnsureUserOption,
+) error {
+	// Apply options
+	options := &EnsureUserOptions{}
+	options.ApplyOptions(opts)
+
+	if err := c.checkVersion(obj.GetType()); err != nil {
+		return err
+	}
+
+	if userName == "" || account == "" {
+		return errors.New("user name and account must be set")
+	}
+
+	key := object.ObjectKey(userName)
+	switch obj.(type) {
+	/////////////////////////////////////////////////////////////////////////////////
+
+	case *types.V0045User:
+		user := &types.V0045User{}
+		params := &v0045api.SlurmdbV0045GetUserParams{WithAssocs: ptr.To("true")}
+		err := c.Get(ctx, key, user, &GetOptions{Params: params})
+		if err != nil && !errors.Is(err, apierrors.ErrObjectNotFound) {
+			return err
+		}
+		exists := err == nil
+		if !exists || !hasV0045UserAssociations(user, account, options) {
+			req := v0045api.V0045OpenapiUsersAddCondResp{
+				AssociationCondition: v0045api.V0045UsersAddCond{
+					Users:    v0045api.V0045StringList{userName},
+					Accounts: &v0045api.V0045StringList{account},
+				},
+			}
+			if len(options.Clusters) > 0 {
+				req.AssociationCondition.Clusters = ptr.To(options.Clusters)
+			}
+			if len(options.Partitions) > 0 {
+				req.AssociationCondition.Partitions = ptr.To(options.Partitions)
+			}
+			if options.DefaultAccount {
+				req.User.Defaultaccount = ptr.To(account)
+			}
+			if _, err := c.v0045Client.CreateUserAssociation(ctx, req); err != nil {
+				return err
+			}
+		}
+		// The user defaults only apply when the user is created.
+		if exists && options.DefaultAccount && user.GetDefaultAccount() != account {
+			// Only send the default account, leaving the other defaults as they are.
+			req := v0045api.V0045User{Name: userName}
+			utils.RemarshalOrDie(map[string]any{"default": map[string]any{"account": account}}, &req)
+			if err := c.v0045Client.UpdateUser(ctx, userName, req); err != nil {
+				return err
+			}
+		}
+
+	/////////////////////////////////////////////////////////////////////////////////
+
+	default:
+		return apierrors.ErrNotImplemented
+	}
+
+	return c.Get(ctx, key, obj, &GetOptions{RefreshCache: true})
+}
+
+// hasV0045UserAssociations returns true if the user is associated with the
+// account on every requested cluster and partition.
+func hasV0045UserAssociations(user *types.V0045User, account string, options *EnsureUserOptions) bool {
+	clusters := options.Clusters
+	if len(clusters) == 0 {
+		clusters = []string{""}
+	}
+	partitions := options.Partitions
+	if len(partitions) == 0 {
+		partitions = []string{""}
+	}
+	for _, cluster := range clusters {
+		for _, partition := range partitions {
+			if !user.HasAssociation(account, cluster, partition) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// refreshInformer refreshes the informer cache of the list type, if the
+// informer has started.
+func (c *client) refreshInformer(ctx context.Context, list object.ObjectList) error {
+	objectType := object.ObjectType(strings.TrimSuffix(string(list.GetType()), "List"))
+	if c.uncached.Has(objectType) {
+		return nil
+	}
+	informerCache := c.GetInformer(objectType)
+	if !informerCache.HasStarted() {
+		return nil
+	}
+	return informerCache.List(ctx, list, &ListOptions{RefreshCache: true})
+}
+
 // Get implements Client.
 func (c *client) Get(
 	ctx context.Context,
@@ -368,7 +833,11 @@ func (c *client) Get(
 	options := &GetOptions{}
 	options.ApplyOptions(opts)
 
-	if !options.SkipCache {
+	if err := c.checkVersion(obj.GetType()); err != nil {
+		return err
+	}
+
+	if !options.SkipCache && options.Params == nil {
 		objectType := obj.GetType()
 		objectType = object.ObjectType(strings.TrimSuffix(string(objectType), "List"))
 		informerCache := c.GetInformer(objectType)
@@ -392,6 +861,12 @@ func (c *client) Get(
 			return err
 		}
 		*o = *out
+	case *types.V0042License:
+		out, err := c.v0042Client.GetLicense(ctx, string(key))
+		if err != nil {
+			return err
+		}
+		*o = *out
 	case *types.V0042Node:
 		out, err := c.v0042Client.GetNode(ctx, string(key))
 		if err != nil {
@@ -431,6 +906,12 @@ func (c *client) Get(
 			return err
 		}
 		*o = *out
+	case *types.V0043License:
+		out, err := c.v0043Client.GetLicense(ctx, string(key))
+		if err != nil {
+			return err
+		}
+		*o = *out
 	case *types.V0043Node:
 		out, err := c.v0043Client.GetNode(ctx, string(key))
 		if err != nil {
@@ -470,6 +951,12 @@ func (c *client) Get(
 			return err
 		}
 		*o = *out
+	case *types.V0044License:
+		out, err := c.v0044Client.GetLicense(ctx, string(key))
+		if err != nil {
+			return err
+		}
+		*o = *out
 	case *types.V0044NodeResourceLayout:
 		out, err := c.v0044Client.GetNodeResourceLayout(ctx, string(key))
 		if err != nil {
@@ -509,18 +996,78 @@ func (c *client) Get(
 
 	/////////////////////////////////////////////////////////////////////////////////
 
+	case *types.V0045Account:
+		out, err := c.v0045Client.GetAccount(ctx, string(key), options.Params)
+		if err != nil {
+			return err
+		}
+		*o = *out
+	case *types.V0045AccountingJob:
+		out, err := c.v0045Client.GetAccountingJob(ctx, string(key))
+		if err != nil {
+			return err
+		}
+		*o = *out
+	case *types.V0045Association:
+		out, err := c.v0045Client.GetAssociation(ctx, string(key), options.Params)
+		if err != nil {
+			return err
+		}
+		*o = *out
+	case *types.V0045Cluster:
+		out, err := c.v0045Client.GetCluster(ctx, string(key), options.Params)
+		if err != nil {
+			return err
+		}
+		*o = *out
+	case *types.V0045Conf:
+		out, err := c.v0045Client.GetConf(ctx)
+		if err != nil {
+			return err
+		}
+		*o = *out
 	case *types.V0045ControllerPing:
 		out, err := c.v0045Client.GetControllerPing(ctx, string(key))
 		if err != nil {
 			return err
 		}
 		*o = *out
+	case *types.V0045DbdPing:
+		out, err := c.v0045Client.GetDbdPing(ctx, string(key))
+		if err != nil {
+			return err
+		}
+		*o = *out
+	case *types.V0045DbdStats:
+		out, err := c.v0045Client.GetDbdStats(ctx)
+		if err != nil {
+			return err
+		}
+		*o = *out
+	case *types.V0045Instance:
+		out, err := c.v0045Client.GetInstance(ctx, string(key))
+		if err != nil {
+			return err
+		}
+		*o = *out
 	case *types.V0045JobInfo:
 		out, err := c.v0045Client.GetJobInfo(ctx, string(key))
 		if err != nil {
 			return err
 		}
 		*o = *out
+	case *types.V0045JobState:
+		out, err := c.v0045Client.GetJobState(ctx, string(key))
+		if err != nil {
+			return err
+		}
+		*o = *out
+	case *types.V0045License:
+		out, err := c.v0045Client.GetLicense(ctx, string(key))
+		if err != nil {
+			return err
+		}
+		*o = *out
 	case *types.V0045NodeResourceLayout:
 		out, err := c.v0045Client.GetNodeResourceLayout(ctx, string(key))
 		if err != nil {
@@ -539,6 +1086,12 @@ func (c *client) Get(
 			return err
 		}
 		*o = *out
+	case *types.V0045Qos:
+		out, err := c.v0045Client.GetQos(ctx, string(key), options.Params)
+		if err != nil {
+			return err
+		}
+		*o = *out
 	case *types.V0045Reconfigure:
 		out, err := c.v0045Client.GetReconfigure(ctx)
 		if err != nil {
@@ -551,12 +1104,36 @@ func (c *client) Get(
 			return err
 		}
 		*o = *out
+	case *types.V0045Shares:
+		out, err := c.v0045Client.GetShares(ctx, string(key))
+		if err != nil {
+			return err
+		}
+		*o = *out
 	case *types.V0045Stats:
 		out, err := c.v0045Client.GetStats(ctx)
 		if err != nil {
 			return err
 		}
 		*o = *out
+	case *types.V0045Tres:
+		out, err := c.v0045Client.GetTres(ctx, string(key))
+		if err != nil {
+			return err
+		}
+		*o = *out
+	case *types.V0045User:
+		out, err := c.v0045Client.GetUser(ctx, string(key), options.Params)
+		if err != nil {
+			return err
+		}
+		*o = *out
+	case *types.V0045WCKey:
+		out, err := c.v0045Client.GetWCKey(ctx, string(key))
+		if err != nil {
+			return err
+		}
+		*o = *out
 
 	/////////////////////////////////////////////////////////////////////////////////
 
@@ -577,7 +1154,11 @@ func (c *client) List(
 	options := &ListOptions{}
 	options.ApplyOptions(opts)
 
-	if !options.SkipCache {
+	if err := c.checkVersion(list.GetType()); err != nil {
+		return err
+	}
+
+	if !options.SkipCache && options.Params == nil {
 		objectType := list.GetType()
 		objectType = object.ObjectType(strings.TrimSuffix(string(objectType), "List"))
 		informerCache := c.GetInformer(objectType)
@@ -602,6 +1183,12 @@ func (c *client) List(
 			return err
 		}
 		*objList = *out
+	case *types.V0042LicenseList:
+		out, err := c.v0042Client.ListLicenses(ctx)
+		if err != nil {
+			return err
+		}
+		*objList = *out
 	case *types.V0042NodeList:
 		out, err := c.v0042Client.ListNodes(ctx)
 		if err != nil {
@@ -641,6 +1228,12 @@ func (c *client) List(
 			return err
 		}
 		*objList = *out
+	case *types.V0043LicenseList:
+		out, err := c.v0043Client.ListLicenses(ctx)
+		if err != nil {
+			return err
+		}
+		*objList = *out
 	case *types.V0043NodeList:
 		out, err := c.v0043Client.ListNodes(ctx)
 		if err != nil {
@@ -680,6 +1273,12 @@ func (c *client) List(
 			return err
 		}
 		*objList = *out
+	case *types.V0044LicenseList:
+		out, err := c.v0044Client.ListLicenses(ctx)
+		if err != nil {
+			return err
+		}
+		*objList = *out
 	case *types.V0044NodeList:
 		out, err := c.v0044Client.ListNodes(ctx)
 		if err != nil {
@@ -713,18 +1312,78 @@ func (c *client) List(
 
 	/////////////////////////////////////////////////////////////////////////////////
 
+	case *types.V0045AccountList:
+		out, err := c.v0045Client.ListAccounts(ctx, options.Params)
+		if err != nil {
+			return err
+		}
+		*objList = *out
+	case *types.V0045AccountingJobList:
+		out, err := c.v0045Client.ListAccountingJobs(ctx, options.Params)
+		if err != nil {
+			return err
+		}
+		*objList = *out
+	case *types.V0045AssociationList:
+		out, err := c.v0045Client.ListAssociations(ctx, options.Params)
+		if err != nil {
+			return err
+		}
+		*objList = *out
+	case *types.V0045ClusterList:
+		out, err := c.v0045Client.ListClusters(ctx, options.Params)
+		if err != nil {
+			return err
+		}
+		*objList = *out
+	case *types.V0045ConfList:
+		out, err := c.v0045Client.ListConf(ctx)
+		if err != nil {
+			return err
+		}
+		*objList = *out
 	case *types.V0045ControllerPingList:
 		out, err := c.v0045Client.ListControllerPing(ctx)
 		if err != nil {
 			return err
 		}
 		*objList = *out
+	case *types.V0045DbdPingList:
+		out, err := c.v0045Client.ListDbdPing(ctx)
+		if err != nil {
+			return err
+		}
+		*objList = *out
+	case *types.V0045DbdStatsList:
+		out, err := c.v0045Client.ListDbdStats(ctx)
+		if err != nil {
+			return err
+		}
+		*objList = *out
+	case *types.V0045InstanceList:
+		out, err := c.v0045Client.ListInstances(ctx, options.Params)
+		if err != nil {
+			return err
+		}
+		*objList = *out
 	case *types.V0045JobInfoList:
 		out, err := c.v0045Client.ListJobInfo(ctx)
 		if err != nil {
 			return err
 		}
 		*objList = *out
+	case *types.V0045JobStateList:
+		out, err := c.v0045Client.ListJobState(ctx, options.Params)
+		if err != nil {
+			return err
+		}
+		*objList = *out
+	case *types.V0045LicenseList:
+		out, err := c.v0045Client.ListLicenses(ctx)
+		if err != nil {
+			return err
+		}
+		*objList = *out
 	case *types.V0045NodeList:
 		out, err := c.v0045Client.ListNodes(ctx)
 		if err != nil {
@@ -737,6 +1396,12 @@ func (c *client) List(
 			return err
 		}
 		*objList = *out
+	case *types.V0045QosList:
+		out, err := c.v0045Client.ListQos(ctx, options.Params)
+		if err != nil {
+			return err
+		}
+		*objList = *out
 	case *types.V0045ReconfigureList:
 		out, err := c.v0045Client.ListReconfigure(ctx)
 		if err != nil {
@@ -749,12 +1414,36 @@ func (c *client) List(
 			return err
 		}
 		*objList = *out
+	case *types.V0045SharesList:
+		out, err := c.v0045Client.ListShares(ctx, options.Params)
+		if err != nil {
+			return err
+		}
+		*objList = *out
 	case *types.V0045StatsList:
 		out, err := c.v0045Client.ListStats(ctx)
 		if err != nil {
 			return err
 		}
 		*objList = *out
+	case *types.V0045TresList:
+		out, err := c.v0045Client.ListTres(ctx)
+		if err != nil {
+			return err
+		}
+		*objList = *out
+	case *types.V0045UserList:
+		out, err := c.v0045Client.ListUsers(ctx, options.Params)
+		if err != nil {
+			return err
+		}
+		*objList = *out
+	case *types.V0045WCKeyList:
+		out, err := c.v0045Client.ListWCKeys(ctx, options.Params)
+		if err != nil {
+			return err
+		}
+		*objList = *out
 
 	/////////////////////////////////////////////////////////////////////////////////
 
@@ -773,9 +1462,14 @@ func (c *client) GetServer() string {
 // GetServer returns the client server.
 func (c *client) SetServer(server string) {
 	c.config.Server = server
+	c.resetVersions()
 	if err := c.createApiClients(); err != nil {
 		panic(fmt.Errorf("unable to create client: %w", err))
 	}
+	// The new server may serve other versions.
+	if c.discoverVersions {
+		c.rediscoverVersions()
+	}
 }
 
 // GetToken returns the current client token.
@@ -832,7 +1526,11 @@ func (c *client) GetInformer(objectType object.ObjectType) InformerCache {
 		return informerCache
 	}
 	// Ensure informer cache exists
-	c.informers[objectType] = newInformer(objectType, c, c.cacheSyncPeriod)
+	informer := newInformer(objectType, c, c.cacheSyncPeriod)
+	if objectType == types.ObjectTypeV0045JobInfo && c.jobStateSync {
+		informer.(*informerCache).jobStateSync = true
+	}
+	c.informers[objectType] = informer
 	return c.informers[objectType]
 }
 
@@ -220,6 +220,47 @@ var _ = Describe("Client v0042", func() {
 		})
 	})
 
+	Describe("V0042License", func() {
+		var cl Client
+
+		BeforeEach(func() {
+			var err error
+			cl, err = NewClient(cfg, &ClientOptions{
+				EnableFor: []object.Object{
+					&types.V0042License{},
+				},
+				CacheSyncPeriod: cacheSyncPeriod,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cl).NotTo(BeNil())
+
+			go cl.Start(context.TODO())
+
+			DeferCleanup(func() {
+				cl.Stop()
+			})
+		})
+
+		Context("Get", func() {
+			It("should fail if the object does not exist", func(ctx SpecContext) {
+				By("fetching non-existent object")
+				obj := &types.V0042License{V0042License: api.V0042License{LicenseName: ptr.To("does-not-exist")}}
+				actual := &types.V0042License{}
+				err := cl.Get(ctx, obj.GetKey(), actual)
+				Expect(err).To(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("List", func() {
+			It("should return a list", func(ctx SpecContext) {
+				By("listing all objects")
+				list := &types.V0042LicenseList{}
+				err := cl.List(ctx, list)
+				Expect(err).NotTo(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+	})
+
 	Describe("V0042Node", func() {
 		var cl Client
 
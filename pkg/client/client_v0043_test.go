@@ -220,6 +220,47 @@ var _ = Describe("Client v0043", func() {
 		})
 	})
 
+	Describe("V0043License", func() {
+		var cl Client
+
+		BeforeEach(func() {
+			var err error
+			cl, err = NewClient(cfg, &ClientOptions{
+				EnableFor: []object.Object{
+					&types.V0043License{},
+				},
+				CacheSyncPeriod: cacheSyncPeriod,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cl).NotTo(BeNil())
+
+			go cl.Start(context.TODO())
+
+			DeferCleanup(func() {
+				cl.Stop()
+			})
+		})
+
+		Context("Get", func() {
+			It("should fail if the object does not exist", func(ctx SpecContext) {
+				By("fetching non-existent object")
+				obj := &types.V0043License{V0043License: api.V0043License{LicenseName: ptr.To("does-not-exist")}}
+				actual := &types.V0043License{}
+				err := cl.Get(ctx, obj.GetKey(), actual)
+				Expect(err).To(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("List", func() {
+			It("should return a list", func(ctx SpecContext) {
+				By("listing all objects")
+				list := &types.V0043LicenseList{}
+				err := cl.List(ctx, list)
+				Expect(err).NotTo(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+	})
+
 	Describe("V0043Node", func() {
 		var cl Client
 
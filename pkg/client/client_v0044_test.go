@@ -220,6 +220,47 @@ var _ = Describe("Client v0044", func() {
 		})
 	})
 
+	Describe("V0044License", func() {
+		var cl Client
+
+		BeforeEach(func() {
+			var err error
+			cl, err = NewClient(cfg, &ClientOptions{
+				EnableFor: []object.Object{
+					&types.V0044License{},
+				},
+				CacheSyncPeriod: cacheSyncPeriod,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cl).NotTo(BeNil())
+
+			go cl.Start(context.TODO())
+
+			DeferCleanup(func() {
+				cl.Stop()
+			})
+		})
+
+		Context("Get", func() {
+			It("should fail if the object does not exist", func(ctx SpecContext) {
+				By("fetching non-existent object")
+				obj := &types.V0044License{V0044License: api.V0044License{LicenseName: ptr.To("does-not-exist")}}
+				actual := &types.V0044License{}
+				err := cl.Get(ctx, obj.GetKey(), actual)
+				Expect(err).To(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("List", func() {
+			It("should return a list", func(ctx SpecContext) {
+				By("listing all objects")
+				list := &types.V0044LicenseList{}
+				err := cl.List(ctx, list)
+				Expect(err).NotTo(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+	})
+
 	Describe("V0044NodeResourceLayout", func() {
 		var cl Client
 		req := api.V0044JobSubmitReq{
@@ -551,6 +592,29 @@ var _ = Describe("Client v0044", func() {
 			}, SpecTimeout(testTimeout))
 		})
 
+		Context("CreateOrUpdateReservations", func() {
+			It("should create all objects", func(ctx SpecContext) {
+				By("creating the objects")
+				reservations := api.V0044ReservationDescMsgList{}
+				for _, reservationName := range []string{"batch-0-v44", "batch-1-v44"} {
+					req := req // shallow copy and scope variable
+					req.Name = ptr.To(reservationName)
+					reservations = append(reservations, req)
+				}
+				list := &types.V0044ReservationInfoList{}
+				results, err := cl.CreateOrUpdateReservations(ctx, list, api.V0044ReservationModReq{Reservations: &reservations})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(results).To(HaveLen(len(reservations)))
+				Expect(list.Items).To(HaveLen(len(reservations)))
+
+				By("deleting the objects")
+				for _, item := range list.Items {
+					err = cl.Delete(ctx, &item)
+					Expect(err).NotTo(HaveOccurred())
+				}
+			}, SpecTimeout(testTimeout))
+		})
+
 		Context("Get", func() {
 			It("should fail if the object does not exist", func(ctx SpecContext) {
 				By("fetching non-existent object")
@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"k8s.io/utils/ptr"
+	"k8s.io/utils/set"
 
 	api "github.com/SlinkyProject/slurm-client/api/v0045"
 	"github.com/SlinkyProject/slurm-client/pkg/client/token"
@@ -32,6 +33,400 @@ var _ = Describe("Client v0045", func() {
 
 	////////////////////////////////////////////////////////////////////////////
 
+	Describe("V0045Account", func() {
+		var cl Client
+
+		BeforeEach(func() {
+			var err error
+			cl, err = NewClient(cfg, &ClientOptions{
+				EnableFor: []object.Object{
+					&types.V0045Account{},
+				},
+				CacheSyncPeriod: cacheSyncPeriod,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cl).NotTo(BeNil())
+
+			go cl.Start(context.TODO())
+
+			DeferCleanup(func() {
+				cl.Stop()
+			})
+		})
+
+		Context("Create", func() {
+			It("should create a new object", func(ctx SpecContext) {
+				const accountName = "create-v45"
+				By("creating the object")
+				obj := &types.V0045Account{}
+				req := api.V0045Account{Name: accountName, Description: comment, Organization: comment}
+				err := cl.Create(ctx, obj, req)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(obj.GetKey()).To(BeEquivalentTo(accountName))
+
+				By("deleting the object")
+				err = cl.Delete(ctx, obj)
+				Expect(err).NotTo(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("Delete", func() {
+			It("should fail if the object does not exist", func(ctx SpecContext) {
+				By("deleting the object")
+				obj := &types.V0045Account{V0045Account: api.V0045Account{Name: "does-not-exist"}}
+				err := cl.Delete(ctx, obj)
+				Expect(err).To(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("Update", func() {
+			It("should update the existing object", func(ctx SpecContext) {
+				const accountName = "update-v45"
+				By("creating the object")
+				obj := &types.V0045Account{}
+				req := api.V0045Account{Name: accountName, Description: comment, Organization: comment}
+				err := cl.Create(ctx, obj, req)
+				Expect(err).NotTo(HaveOccurred())
+
+				By("update the object")
+				updateReq := api.V0045Account{Description: "updated", Organization: comment}
+				err = cl.Update(ctx, obj, updateReq)
+				Expect(err).NotTo(HaveOccurred())
+
+				By("validating the object field was updated")
+				Expect(obj.Description).To(Equal(updateReq.Description))
+
+				By("deleting the object")
+				err = cl.Delete(ctx, obj)
+				Expect(err).NotTo(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("Get", func() {
+			It("should fail if the object does not exist", func(ctx SpecContext) {
+				By("fetching non-existent object")
+				actual := &types.V0045Account{}
+				err := cl.Get(ctx, "does-not-exist", actual)
+				Expect(err).To(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+			It("should return existing object", func(ctx SpecContext) {
+				By("fetching existent object")
+				actual := &types.V0045Account{}
+				err := cl.Get(ctx, "root", actual)
+				Expect(err).NotTo(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+			It("should return existing object with params", func(ctx SpecContext) {
+				By("fetching existent object with coordinators")
+				actual := &types.V0045Account{}
+				params := &api.SlurmdbV0045GetAccountParams{WithCoords: ptr.To("true")}
+				err := cl.Get(ctx, "root", actual, &GetOptions{Params: params})
+				Expect(err).NotTo(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("List", func() {
+			It("should return a list", func(ctx SpecContext) {
+				By("listing all objects")
+				list := &types.V0045AccountList{}
+				err := cl.List(ctx, list)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(list.Items).NotTo(BeEmpty())
+			}, SpecTimeout(testTimeout))
+			It("should return a list with params", func(ctx SpecContext) {
+				By("listing all objects with associations")
+				list := &types.V0045AccountList{}
+				params := &api.SlurmdbV0045GetAccountsParams{WithAssociations: ptr.To("true")}
+				err := cl.List(ctx, list, &ListOptions{Params: params})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(list.Items).NotTo(BeEmpty())
+			}, SpecTimeout(testTimeout))
+		})
+	})
+
+	Describe("V0045AccountingJob", func() {
+		var cl Client
+
+		BeforeEach(func() {
+			var err error
+			cl, err = NewClient(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cl).NotTo(BeNil())
+		})
+
+		Context("Get", func() {
+			It("should fail if the object does not exist", func(ctx SpecContext) {
+				By("fetching non-existent object")
+				actual := &types.V0045AccountingJob{}
+				err := cl.Get(ctx, "99999999", actual)
+				Expect(err).To(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("List", func() {
+			It("should return a list", func(ctx SpecContext) {
+				By("listing the last day of jobs")
+				query := types.NewV0045AccountingJobQuery().
+					Between(time.Now().Add(-24*time.Hour), time.Now())
+				list := &types.V0045AccountingJobList{}
+				err := cl.List(ctx, list, &ListOptions{Params: query})
+				Expect(err).NotTo(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+			It("should page over time windows", func(ctx SpecContext) {
+				By("paging the last day of jobs by the hour")
+				query := types.NewV0045AccountingJobQuery().
+					Between(time.Now().Add(-24*time.Hour), time.Now())
+				seen := set.New[object.ObjectKey]()
+				err := ListV0045AccountingJobPages(ctx, cl, query, time.Hour, func(page *types.V0045AccountingJobList) error {
+					for _, item := range page.Items {
+						Expect(seen.Has(item.GetKey())).To(BeFalse())
+						seen.Insert(item.GetKey())
+					}
+					return nil
+				})
+				Expect(err).NotTo(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+	})
+
+	Describe("V0045Association", func() {
+		var cl Client
+
+		BeforeEach(func() {
+			var err error
+			cl, err = NewClient(cfg, &ClientOptions{
+				EnableFor: []object.Object{
+					&types.V0045Association{},
+				},
+				CacheSyncPeriod: cacheSyncPeriod,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cl).NotTo(BeNil())
+
+			go cl.Start(context.TODO())
+
+			DeferCleanup(func() {
+				cl.Stop()
+			})
+		})
+
+		// getRootAssociation returns the root account association of the cluster.
+		getRootAssociation := func(ctx context.Context) *types.V0045Association {
+			list := &types.V0045AssociationList{}
+			params := &api.SlurmdbV0045GetAssociationsParams{Account: ptr.To("root")}
+			err := cl.List(ctx, list, &ListOptions{Params: params})
+			Expect(err).NotTo(HaveOccurred())
+			for _, item := range list.Items {
+				if item.User == "" {
+					return &item
+				}
+			}
+			Fail("root association not found")
+			return nil
+		}
+
+		Context("Create", func() {
+			It("should create, update, and delete an object", func(ctx SpecContext) {
+				const accountName = "assoc-v45"
+				root := getRootAssociation(ctx)
+
+				By("creating the account")
+				account := &types.V0045Account{}
+				accountReq := api.V0045Account{Name: accountName, Description: comment, Organization: comment}
+				err := cl.Create(ctx, account, accountReq)
+				Expect(err).NotTo(HaveOccurred())
+				DeferCleanup(func(ctx SpecContext) {
+					By("deleting the account")
+					err := cl.Delete(ctx, account)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				By("creating the object")
+				obj := &types.V0045Association{}
+				req := api.V0045Assoc{
+					Cluster:       root.Cluster,
+					Account:       ptr.To(accountName),
+					ParentAccount: ptr.To("root"),
+				}
+				err = cl.Create(ctx, obj, req)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(obj.GetAssociationKey()).To(Equal(types.AssociationKey{
+					Cluster: ptr.Deref(root.Cluster, ""),
+					Account: accountName,
+				}))
+
+				By("updating the object limits")
+				obj.SetMaxJobs(10)
+				obj.SetGrpTRES(api.V0045TresList{{Type: "cpu", Count: ptr.To[int64](4)}})
+				err = cl.Update(ctx, obj, obj.V0045Assoc)
+				Expect(err).NotTo(HaveOccurred())
+				maxJobs, ok := obj.GetMaxJobs()
+				Expect(ok).To(BeTrue())
+				Expect(maxJobs).To(BeEquivalentTo(10))
+
+				By("deleting the object")
+				err = cl.Delete(ctx, obj)
+				Expect(err).NotTo(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("Get", func() {
+			It("should fail if the object does not exist", func(ctx SpecContext) {
+				By("fetching non-existent object")
+				key := types.AssociationKey{Cluster: "does-not-exist", Account: "root"}
+				actual := &types.V0045Association{}
+				err := cl.Get(ctx, key.ObjectKey(), actual)
+				Expect(err).To(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+			It("should return existing object", func(ctx SpecContext) {
+				By("fetching existent object")
+				root := getRootAssociation(ctx)
+				actual := &types.V0045Association{}
+				err := cl.Get(ctx, root.GetKey(), actual)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actual.GetKey()).To(Equal(root.GetKey()))
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("List", func() {
+			It("should return a list", func(ctx SpecContext) {
+				By("listing all objects")
+				list := &types.V0045AssociationList{}
+				err := cl.List(ctx, list)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(list.Items).NotTo(BeEmpty())
+			}, SpecTimeout(testTimeout))
+		})
+	})
+
+	Describe("V0045Cluster", func() {
+		var cl Client
+
+		BeforeEach(func() {
+			var err error
+			cl, err = NewClient(cfg, &ClientOptions{
+				EnableFor: []object.Object{
+					&types.V0045Cluster{},
+				},
+				CacheSyncPeriod: cacheSyncPeriod,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cl).NotTo(BeNil())
+
+			go cl.Start(context.TODO())
+
+			DeferCleanup(func() {
+				cl.Stop()
+			})
+		})
+
+		Context("Create", func() {
+			It("should create, update, and delete an object", func(ctx SpecContext) {
+				const clusterName = "create-v45"
+				By("creating the object")
+				obj := &types.V0045Cluster{}
+				req := api.V0045ClusterRec{Name: ptr.To(clusterName)}
+				err := cl.Create(ctx, obj, req)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(obj.GetKey()).To(BeEquivalentTo(clusterName))
+
+				By("updating the object")
+				updateReq := api.V0045ClusterRec{Nodes: ptr.To("")}
+				err = cl.Update(ctx, obj, updateReq)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(obj.GetKey()).To(BeEquivalentTo(clusterName))
+
+				By("deleting the object")
+				err = cl.Delete(ctx, obj)
+				Expect(err).NotTo(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("Delete", func() {
+			It("should fail if the object does not exist", func(ctx SpecContext) {
+				By("deleting the object")
+				obj := &types.V0045Cluster{V0045ClusterRec: api.V0045ClusterRec{Name: ptr.To("does-not-exist")}}
+				err := cl.Delete(ctx, obj)
+				Expect(err).To(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("Get", func() {
+			It("should fail if the object does not exist", func(ctx SpecContext) {
+				By("fetching non-existent object")
+				actual := &types.V0045Cluster{}
+				err := cl.Get(ctx, "does-not-exist", actual)
+				Expect(err).To(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+			It("should return existing object", func(ctx SpecContext) {
+				By("listing all objects")
+				list := &types.V0045ClusterList{}
+				err := cl.List(ctx, list)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(list.Items).NotTo(BeEmpty())
+
+				By("fetching existent object")
+				actual := &types.V0045Cluster{}
+				err = cl.Get(ctx, list.Items[0].GetKey(), actual)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actual.GetControllerHost()).NotTo(BeEmpty())
+				Expect(actual.GetRpcVersion()).NotTo(BeZero())
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("List", func() {
+			It("should return a list", func(ctx SpecContext) {
+				By("listing all objects")
+				list := &types.V0045ClusterList{}
+				err := cl.List(ctx, list)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(list.Items).NotTo(BeEmpty())
+			}, SpecTimeout(testTimeout))
+		})
+	})
+
+	Describe("V0045Conf", func() {
+		var cl Client
+
+		BeforeEach(func() {
+			var err error
+			cl, err = NewClient(cfg, &ClientOptions{
+				EnableFor: []object.Object{
+					&types.V0045Conf{},
+				},
+				CacheSyncPeriod: cacheSyncPeriod,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cl).NotTo(BeNil())
+
+			go cl.Start(context.TODO())
+
+			DeferCleanup(func() {
+				cl.Stop()
+			})
+		})
+
+		Context("Get", func() {
+			It("should fetch the configuration", func(ctx SpecContext) {
+				By("fetching data")
+				obj := &types.V0045Conf{}
+				err := cl.Get(ctx, obj.GetKey(), obj)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(obj.GetSelectType()).NotTo(BeEmpty())
+				Expect(obj.GetAuthType()).NotTo(BeEmpty())
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("List", func() {
+			It("should return a list", func(ctx SpecContext) {
+				By("listing all objects")
+				list := &types.V0045ConfList{}
+				err := cl.List(ctx, list)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(list.Items).NotTo(BeEmpty())
+			}, SpecTimeout(testTimeout))
+		})
+	})
+
 	Describe("V0045ControllerPing", func() {
 		var cl Client
 
@@ -39,7 +434,131 @@ var _ = Describe("Client v0045", func() {
 			var err error
 			cl, err = NewClient(cfg, &ClientOptions{
 				EnableFor: []object.Object{
-					&types.V0045ControllerPing{},
+					&types.V0045ControllerPing{},
+				},
+				CacheSyncPeriod: cacheSyncPeriod,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cl).NotTo(BeNil())
+
+			go cl.Start(context.TODO())
+
+			DeferCleanup(func() {
+				cl.Stop()
+			})
+		})
+
+		Context("Get", func() {
+			It("should fail if the object does not exist", func(ctx SpecContext) {
+				By("fetching non-existent object")
+				obj := &types.V0045ControllerPing{}
+				key := object.ObjectKey("slurmctld")
+				err := cl.Get(ctx, key, obj)
+				Expect(err).NotTo(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("List", func() {
+			It("should return a non-empty list ", func(ctx SpecContext) {
+				By("listing all objects")
+				list := &types.V0045ControllerPingList{}
+				err := cl.List(ctx, list)
+				Expect(err).NotTo(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+	})
+
+	Describe("V0045DbdPing", func() {
+		var cl Client
+
+		BeforeEach(func() {
+			var err error
+			cl, err = NewClient(cfg, &ClientOptions{
+				EnableFor: []object.Object{
+					&types.V0045DbdPing{},
+				},
+				CacheSyncPeriod: cacheSyncPeriod,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cl).NotTo(BeNil())
+
+			go cl.Start(context.TODO())
+
+			DeferCleanup(func() {
+				cl.Stop()
+			})
+		})
+
+		Context("Get", func() {
+			It("should fail if the object does not exist", func(ctx SpecContext) {
+				By("fetching non-existent object")
+				obj := &types.V0045DbdPing{}
+				key := object.ObjectKey("does-not-exist")
+				err := cl.Get(ctx, key, obj)
+				Expect(err).To(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("List", func() {
+			It("should return a non-empty list", func(ctx SpecContext) {
+				By("listing all objects")
+				list := &types.V0045DbdPingList{}
+				err := cl.List(ctx, list)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(list.Items).NotTo(BeEmpty())
+			}, SpecTimeout(testTimeout))
+		})
+	})
+
+	Describe("V0045DbdStats", func() {
+		var cl Client
+
+		BeforeEach(func() {
+			var err error
+			cl, err = NewClient(cfg, &ClientOptions{
+				EnableFor: []object.Object{
+					&types.V0045DbdStats{},
+				},
+				CacheSyncPeriod: cacheSyncPeriod,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cl).NotTo(BeNil())
+
+			go cl.Start(context.TODO())
+
+			DeferCleanup(func() {
+				cl.Stop()
+			})
+		})
+
+		Context("Get", func() {
+			It("should fetch stats", func(ctx SpecContext) {
+				By("fetching data")
+				obj := &types.V0045DbdStats{}
+				err := cl.Get(ctx, obj.GetKey(), obj)
+				Expect(err).NotTo(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("List", func() {
+			It("should return a list", func(ctx SpecContext) {
+				By("listing all objects")
+				list := &types.V0045DbdStatsList{}
+				err := cl.List(ctx, list)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(list.Items).NotTo(BeEmpty())
+			}, SpecTimeout(testTimeout))
+		})
+	})
+
+	Describe("V0045Instance", func() {
+		var cl Client
+
+		BeforeEach(func() {
+			var err error
+			cl, err = NewClient(cfg, &ClientOptions{
+				EnableFor: []object.Object{
+					&types.V0045Instance{},
 				},
 				CacheSyncPeriod: cacheSyncPeriod,
 			})
@@ -56,20 +575,29 @@ var _ = Describe("Client v0045", func() {
 		Context("Get", func() {
 			It("should fail if the object does not exist", func(ctx SpecContext) {
 				By("fetching non-existent object")
-				obj := &types.V0045ControllerPing{}
-				key := object.ObjectKey("slurmctld")
+				obj := &types.V0045Instance{}
+				key := object.ObjectKey("does-not-exist")
 				err := cl.Get(ctx, key, obj)
-				Expect(err).NotTo(HaveOccurred())
+				Expect(err).To(HaveOccurred())
 			}, SpecTimeout(testTimeout))
 		})
 
 		Context("List", func() {
-			It("should return a non-empty list ", func(ctx SpecContext) {
+			It("should return a list", func(ctx SpecContext) {
 				By("listing all objects")
-				list := &types.V0045ControllerPingList{}
+				list := &types.V0045InstanceList{}
 				err := cl.List(ctx, list)
 				Expect(err).NotTo(HaveOccurred())
 			}, SpecTimeout(testTimeout))
+
+			It("should filter by query", func(ctx SpecContext) {
+				By("listing objects of a node")
+				list := &types.V0045InstanceList{}
+				query := types.NewV0045InstanceQuery().WithNodes("does-not-exist")
+				err := cl.List(ctx, list, &ListOptions{Params: query})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(list.Items).To(BeEmpty())
+			}, SpecTimeout(testTimeout))
 		})
 	})
 
@@ -125,6 +653,19 @@ var _ = Describe("Client v0045", func() {
 				err := cl.Create(ctx, obj, req)
 				Expect(err).To(HaveOccurred())
 			}, SpecTimeout(testTimeout))
+			It("should allocate a new object", func(ctx SpecContext) {
+				By("allocating the object")
+				obj := &types.V0045JobInfo{}
+				allocReq := api.V0045JobAllocReq{
+					Job: &api.V0045JobDescMsg{
+						Environment:             req.Job.Environment,
+						CurrentWorkingDirectory: req.Job.CurrentWorkingDirectory,
+					},
+				}
+				err := cl.Create(ctx, obj, allocReq, &CreateOptions{Allocate: true})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(obj.GetKey()).NotTo(BeEquivalentTo("0"))
+			}, SpecTimeout(testTimeout))
 		})
 
 		Context("Delete", func() {
@@ -172,6 +713,70 @@ var _ = Describe("Client v0045", func() {
 			}, SpecTimeout(testTimeout))
 		})
 
+		Context("KillJobs", func() {
+			It("should fail without a filter", func(ctx SpecContext) {
+				By("deleting all objects")
+				_, err := cl.KillJobs(ctx, &types.V0045JobInfo{})
+				Expect(err).To(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+			It("should cancel all matching objects", func(ctx SpecContext) {
+				By("creating the objects")
+				jobIds := []string{}
+				for range 2 {
+					obj := &types.V0045JobInfo{}
+					err := cl.Create(ctx, obj, req)
+					Expect(err).NotTo(HaveOccurred())
+					jobIds = append(jobIds, string(obj.GetKey()))
+				}
+
+				By("deleting all objects")
+				results, err := cl.KillJobs(ctx, &types.V0045JobInfo{}, &KillJobsOptions{JobIds: jobIds})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(results).To(HaveLen(len(jobIds)))
+				for _, result := range results {
+					Expect(result.Error).NotTo(HaveOccurred())
+				}
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("RequeueJob", func() {
+			It("should fail if the object does not exist", func(ctx SpecContext) {
+				By("requeuing the object")
+				obj := &types.V0045JobInfo{V0045JobInfo: api.V0045JobInfo{JobId: ptr.To[int32](0)}}
+				_, err := cl.RequeueJob(ctx, obj)
+				Expect(err).To(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+			It("should requeue the existing object", func(ctx SpecContext) {
+				By("creating the object")
+				obj := &types.V0045JobInfo{}
+				err := cl.Create(ctx, obj, req)
+				Expect(err).NotTo(HaveOccurred())
+
+				By("requeuing the object")
+				results, err := cl.RequeueJob(ctx, obj, &RequeueOptions{Hold: true})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(results).NotTo(BeEmpty())
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("RequeueJobs", func() {
+			It("should requeue all objects in the list", func(ctx SpecContext) {
+				By("creating the objects")
+				list := &types.V0045JobInfoList{}
+				for range 2 {
+					obj := &types.V0045JobInfo{}
+					err := cl.Create(ctx, obj, req)
+					Expect(err).NotTo(HaveOccurred())
+					list.AppendItem(obj)
+				}
+
+				By("requeuing the objects")
+				results, err := cl.RequeueJobs(ctx, list, &RequeueOptions{Hold: true})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(results).To(HaveLen(len(list.Items)))
+			}, SpecTimeout(testTimeout))
+		})
+
 		Context("Get", func() {
 			It("should fail if the object does not exist", func(ctx SpecContext) {
 				By("fetching non-existent object")
@@ -220,6 +825,124 @@ var _ = Describe("Client v0045", func() {
 		})
 	})
 
+	Describe("V0045JobState", func() {
+		var cl Client
+		req := api.V0045JobSubmitReq{
+			Job: &api.V0045JobDescMsg{
+				Environment: &api.V0045StringArray{
+					"PATH=/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin",
+				},
+				CurrentWorkingDirectory: ptr.To("/tmp"),
+				Script:                  ptr.To("#!/usr/bin/sh\nexit 0"),
+				Hold:                    ptr.To(true),
+			},
+		}
+
+		BeforeEach(func() {
+			var err error
+			cl, err = NewClient(cfg, &ClientOptions{
+				EnableFor: []object.Object{
+					&types.V0045JobInfo{},
+					&types.V0045JobState{},
+				},
+				CacheSyncPeriod: cacheSyncPeriod,
+				JobStateSync:    true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cl).NotTo(BeNil())
+
+			go cl.Start(context.TODO())
+
+			DeferCleanup(func() {
+				cl.Stop()
+			})
+		})
+
+		Context("Get", func() {
+			It("should fail if the object does not exist", func(ctx SpecContext) {
+				By("fetching non-existent object")
+				obj := &types.V0045JobState{}
+				key := object.ObjectKey("0")
+				err := cl.Get(ctx, key, obj)
+				Expect(err).To(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+			It("should fetch the state of an existing job", func(ctx SpecContext) {
+				By("initially creating a job")
+				job := &types.V0045JobInfo{}
+				err := cl.Create(ctx, job, req)
+				Expect(err).NotTo(HaveOccurred())
+
+				By("fetching the job state")
+				actual := &types.V0045JobState{}
+				err = cl.Get(ctx, job.GetKey(), actual, &GetOptions{RefreshCache: true})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actual.GetStateAsSet()).To(BeEquivalentTo(job.GetStateAsSet()))
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("List", func() {
+			It("should return a list", func(ctx SpecContext) {
+				By("listing all objects")
+				list := &types.V0045JobStateList{}
+				err := cl.List(ctx, list)
+				Expect(err).NotTo(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+			It("should sync full jobs through job states", func(ctx SpecContext) {
+				By("initially creating a job")
+				job := &types.V0045JobInfo{}
+				err := cl.Create(ctx, job, req)
+				Expect(err).NotTo(HaveOccurred())
+
+				By("listing all jobs")
+				list := &types.V0045JobInfoList{}
+				err = cl.List(ctx, list, &ListOptions{RefreshCache: true})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(list.Items).NotTo(BeEmpty())
+			}, SpecTimeout(testTimeout))
+		})
+	})
+
+	Describe("V0045License", func() {
+		var cl Client
+
+		BeforeEach(func() {
+			var err error
+			cl, err = NewClient(cfg, &ClientOptions{
+				EnableFor: []object.Object{
+					&types.V0045License{},
+				},
+				CacheSyncPeriod: cacheSyncPeriod,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cl).NotTo(BeNil())
+
+			go cl.Start(context.TODO())
+
+			DeferCleanup(func() {
+				cl.Stop()
+			})
+		})
+
+		Context("Get", func() {
+			It("should fail if the object does not exist", func(ctx SpecContext) {
+				By("fetching non-existent object")
+				obj := &types.V0045License{V0045License: api.V0045License{LicenseName: ptr.To("does-not-exist")}}
+				actual := &types.V0045License{}
+				err := cl.Get(ctx, obj.GetKey(), actual)
+				Expect(err).To(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("List", func() {
+			It("should return a list", func(ctx SpecContext) {
+				By("listing all objects")
+				list := &types.V0045LicenseList{}
+				err := cl.List(ctx, list)
+				Expect(err).NotTo(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+	})
+
 	Describe("V0045NodeResourceLayout", func() {
 		var cl Client
 		req := api.V0045JobSubmitReq{
@@ -319,22 +1042,164 @@ var _ = Describe("Client v0045", func() {
 				Expect(err).NotTo(HaveOccurred())
 
 				By("validating the object field was updated")
-				Expect(obj.Comment).To(BeEquivalentTo(req.Comment))
+				Expect(obj.Comment).To(BeEquivalentTo(req.Comment))
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("UpdateNodes", func() {
+			req := api.V0045UpdateNodeMsg{
+				Comment: ptr.To(comment),
+			}
+
+			It("should fail if the objects do not exist", func(ctx SpecContext) {
+				By("update the objects")
+				list := &types.V0045NodeList{}
+				err := cl.UpdateNodes(ctx, list, []string{"does-not-exist[0-1]"}, req)
+				Expect(err).To(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+			It("should update the existing objects", func(ctx SpecContext) {
+				By("update the objects")
+				list := &types.V0045NodeList{}
+				err := cl.UpdateNodes(ctx, list, []string{"slurmd"}, req)
+				Expect(err).NotTo(HaveOccurred())
+
+				By("validating the object field was updated")
+				Expect(list.Items).To(HaveLen(1))
+				Expect(list.Items[0].Comment).To(BeEquivalentTo(req.Comment))
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("Get", func() {
+			It("should fail if the object does not exist", func(ctx SpecContext) {
+				By("fetching non-existent object")
+				obj := &types.V0045Node{V0045Node: api.V0045Node{Name: ptr.To("does-not-exist")}}
+				actual := &types.V0045Node{}
+				err := cl.Get(ctx, obj.GetKey(), actual)
+				Expect(err).To(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+			It("should return existing object", func(ctx SpecContext) {
+				By("fetching existent object")
+				obj := &types.V0045Node{V0045Node: api.V0045Node{Name: ptr.To("slurmd")}}
+				actual := &types.V0045Node{}
+				err := cl.Get(ctx, obj.GetKey(), actual)
+				Expect(err).NotTo(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("List", func() {
+			It("should return a list", func(ctx SpecContext) {
+				By("listing all objects")
+				list := &types.V0045NodeList{}
+				err := cl.List(ctx, list)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(list.Items).NotTo(BeEmpty())
+			}, SpecTimeout(testTimeout))
+		})
+	})
+
+	Describe("V0045PartitionInfo", func() {
+		var cl Client
+
+		BeforeEach(func() {
+			var err error
+			cl, err = NewClient(cfg, &ClientOptions{
+				EnableFor: []object.Object{
+					&types.V0045PartitionInfo{},
+				},
+				CacheSyncPeriod: cacheSyncPeriod,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cl).NotTo(BeNil())
+
+			go cl.Start(context.TODO())
+
+			DeferCleanup(func() {
+				cl.Stop()
+			})
+		})
+
+		Context("Create", func() {
+			It("should create a new object", func(ctx SpecContext) {
+				const partitionName = "create-v45"
+				By("creating the object")
+				obj := &types.V0045PartitionInfo{}
+				req := api.V0045PartitionInfo{Name: ptr.To(partitionName)}
+				err := cl.Create(ctx, obj, req)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(obj.GetKey()).To(BeEquivalentTo(partitionName))
+
+				By("deleting the object")
+				err = cl.Delete(ctx, obj)
+				Expect(err).NotTo(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+			It("should fail if the object request is invalid", func(ctx SpecContext) {
+				By("creating the object")
+				obj := &types.V0045PartitionInfo{}
+				req := api.V0045PartitionInfo{}
+				err := cl.Create(ctx, obj, req)
+				Expect(err).To(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("Delete", func() {
+			It("should fail if the object does not exist", func(ctx SpecContext) {
+				By("deleting the object")
+				obj := &types.V0045PartitionInfo{V0045PartitionInfo: api.V0045PartitionInfo{Name: ptr.To("does-not-exist")}}
+				err := cl.Delete(ctx, obj)
+				Expect(err).To(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+			It("should delete an existing object", func(ctx SpecContext) {
+				const partitionName = "delete-v45"
+				By("creating the object")
+				obj := &types.V0045PartitionInfo{}
+				req := api.V0045PartitionInfo{Name: ptr.To(partitionName)}
+				err := cl.Create(ctx, obj, req)
+				Expect(err).NotTo(HaveOccurred())
+
+				By("deleting the object")
+				err = cl.Delete(ctx, obj)
+				Expect(err).NotTo(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("Update", func() {
+			updateReq := api.V0045PartitionInfo{
+				GraceTime: ptr.To[int32](30),
+			}
+
+			It("should update the existing object", func(ctx SpecContext) {
+				const partitionName = "update-v45"
+				By("creating the object")
+				obj := &types.V0045PartitionInfo{}
+				req := api.V0045PartitionInfo{Name: ptr.To(partitionName)}
+				err := cl.Create(ctx, obj, req)
+				Expect(err).NotTo(HaveOccurred())
+
+				By("update the object")
+				err = cl.Update(ctx, obj, updateReq)
+				Expect(err).NotTo(HaveOccurred())
+
+				By("validating the object field was updated")
+				Expect(obj.GraceTime).To(BeEquivalentTo(updateReq.GraceTime))
+
+				By("deleting the object")
+				err = cl.Delete(ctx, obj)
+				Expect(err).NotTo(HaveOccurred())
 			}, SpecTimeout(testTimeout))
 		})
 
 		Context("Get", func() {
 			It("should fail if the object does not exist", func(ctx SpecContext) {
 				By("fetching non-existent object")
-				obj := &types.V0045Node{V0045Node: api.V0045Node{Name: ptr.To("does-not-exist")}}
-				actual := &types.V0045Node{}
+				obj := &types.V0045PartitionInfo{V0045PartitionInfo: api.V0045PartitionInfo{Name: ptr.To("does-not-exist")}}
+				actual := &types.V0045PartitionInfo{}
 				err := cl.Get(ctx, obj.GetKey(), actual)
 				Expect(err).To(HaveOccurred())
 			}, SpecTimeout(testTimeout))
 			It("should return existing object", func(ctx SpecContext) {
 				By("fetching existent object")
-				obj := &types.V0045Node{V0045Node: api.V0045Node{Name: ptr.To("slurmd")}}
-				actual := &types.V0045Node{}
+				obj := &types.V0045PartitionInfo{V0045PartitionInfo: api.V0045PartitionInfo{Name: ptr.To("all")}}
+				actual := &types.V0045PartitionInfo{}
 				err := cl.Get(ctx, obj.GetKey(), actual)
 				Expect(err).NotTo(HaveOccurred())
 			}, SpecTimeout(testTimeout))
@@ -343,7 +1208,7 @@ var _ = Describe("Client v0045", func() {
 		Context("List", func() {
 			It("should return a list", func(ctx SpecContext) {
 				By("listing all objects")
-				list := &types.V0045NodeList{}
+				list := &types.V0045PartitionInfoList{}
 				err := cl.List(ctx, list)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(list.Items).NotTo(BeEmpty())
@@ -351,14 +1216,14 @@ var _ = Describe("Client v0045", func() {
 		})
 	})
 
-	Describe("V0045PartitionInfo", func() {
+	Describe("V0045Qos", func() {
 		var cl Client
 
 		BeforeEach(func() {
 			var err error
 			cl, err = NewClient(cfg, &ClientOptions{
 				EnableFor: []object.Object{
-					&types.V0045PartitionInfo{},
+					&types.V0045Qos{},
 				},
 				CacheSyncPeriod: cacheSyncPeriod,
 			})
@@ -372,19 +1237,67 @@ var _ = Describe("Client v0045", func() {
 			})
 		})
 
+		Context("Create", func() {
+			It("should create a new object", func(ctx SpecContext) {
+				const qosName = "create-v45"
+				By("creating the object")
+				obj := &types.V0045Qos{}
+				req := api.V0045Qos{Name: ptr.To(qosName)}
+				err := cl.Create(ctx, obj, req)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(obj.GetKey()).To(BeEquivalentTo(qosName))
+
+				By("deleting the object")
+				err = cl.Delete(ctx, obj)
+				Expect(err).NotTo(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("Delete", func() {
+			It("should fail if the object does not exist", func(ctx SpecContext) {
+				By("deleting the object")
+				obj := &types.V0045Qos{V0045Qos: api.V0045Qos{Name: ptr.To("does-not-exist")}}
+				err := cl.Delete(ctx, obj)
+				Expect(err).To(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("Update", func() {
+			It("should update the existing object", func(ctx SpecContext) {
+				const qosName = "update-v45"
+				By("creating the object")
+				obj := &types.V0045Qos{}
+				req := api.V0045Qos{Name: ptr.To(qosName)}
+				err := cl.Create(ctx, obj, req)
+				Expect(err).NotTo(HaveOccurred())
+
+				By("update the object")
+				updateReq := api.V0045Qos{
+					Priority: &api.V0045Uint32NoValStruct{Number: ptr.To[int32](100), Set: ptr.To(true)},
+				}
+				err = cl.Update(ctx, obj, updateReq)
+				Expect(err).NotTo(HaveOccurred())
+
+				By("validating the object field was updated")
+				Expect(obj.Priority.Number).To(BeEquivalentTo(updateReq.Priority.Number))
+
+				By("deleting the object")
+				err = cl.Delete(ctx, obj)
+				Expect(err).NotTo(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+
 		Context("Get", func() {
 			It("should fail if the object does not exist", func(ctx SpecContext) {
 				By("fetching non-existent object")
-				obj := &types.V0045PartitionInfo{V0045PartitionInfo: api.V0045PartitionInfo{Name: ptr.To("does-not-exist")}}
-				actual := &types.V0045PartitionInfo{}
-				err := cl.Get(ctx, obj.GetKey(), actual)
+				actual := &types.V0045Qos{}
+				err := cl.Get(ctx, "does-not-exist", actual)
 				Expect(err).To(HaveOccurred())
 			}, SpecTimeout(testTimeout))
 			It("should return existing object", func(ctx SpecContext) {
 				By("fetching existent object")
-				obj := &types.V0045PartitionInfo{V0045PartitionInfo: api.V0045PartitionInfo{Name: ptr.To("all")}}
-				actual := &types.V0045PartitionInfo{}
-				err := cl.Get(ctx, obj.GetKey(), actual)
+				actual := &types.V0045Qos{}
+				err := cl.Get(ctx, "normal", actual)
 				Expect(err).NotTo(HaveOccurred())
 			}, SpecTimeout(testTimeout))
 		})
@@ -392,7 +1305,7 @@ var _ = Describe("Client v0045", func() {
 		Context("List", func() {
 			It("should return a list", func(ctx SpecContext) {
 				By("listing all objects")
-				list := &types.V0045PartitionInfoList{}
+				list := &types.V0045QosList{}
 				err := cl.List(ctx, list)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(list.Items).NotTo(BeEmpty())
@@ -551,6 +1464,29 @@ var _ = Describe("Client v0045", func() {
 			}, SpecTimeout(testTimeout))
 		})
 
+		Context("CreateOrUpdateReservations", func() {
+			It("should create all objects", func(ctx SpecContext) {
+				By("creating the objects")
+				reservations := api.V0045ReservationDescMsgList{}
+				for _, reservationName := range []string{"batch-0-v45", "batch-1-v45"} {
+					req := req // shallow copy and scope variable
+					req.Name = ptr.To(reservationName)
+					reservations = append(reservations, req)
+				}
+				list := &types.V0045ReservationInfoList{}
+				results, err := cl.CreateOrUpdateReservations(ctx, list, api.V0045ReservationModReq{Reservations: &reservations})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(results).To(HaveLen(len(reservations)))
+				Expect(list.Items).To(HaveLen(len(reservations)))
+
+				By("deleting the objects")
+				for _, item := range list.Items {
+					err = cl.Delete(ctx, &item)
+					Expect(err).NotTo(HaveOccurred())
+				}
+			}, SpecTimeout(testTimeout))
+		})
+
 		Context("Get", func() {
 			It("should fail if the object does not exist", func(ctx SpecContext) {
 				By("fetching non-existent object")
@@ -602,6 +1538,56 @@ var _ = Describe("Client v0045", func() {
 		})
 	})
 
+	Describe("V0045Shares", func() {
+		var cl Client
+
+		BeforeEach(func() {
+			var err error
+			cl, err = NewClient(cfg, &ClientOptions{
+				EnableFor: []object.Object{
+					&types.V0045Shares{},
+				},
+				CacheSyncPeriod: cacheSyncPeriod,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cl).NotTo(BeNil())
+
+			go cl.Start(context.TODO())
+
+			DeferCleanup(func() {
+				cl.Stop()
+			})
+		})
+
+		Context("Get", func() {
+			It("should fail if the object does not exist", func(ctx SpecContext) {
+				By("fetching non-existent object")
+				obj := &types.V0045Shares{V0045AssocSharesObjWrap: api.V0045AssocSharesObjWrap{Id: ptr.To[int32](-1)}}
+				actual := &types.V0045Shares{}
+				err := cl.Get(ctx, obj.GetKey(), actual)
+				Expect(err).To(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("List", func() {
+			It("should return a list", func(ctx SpecContext) {
+				By("listing all objects")
+				list := &types.V0045SharesList{}
+				err := cl.List(ctx, list)
+				Expect(err).NotTo(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+
+			It("should filter by params", func(ctx SpecContext) {
+				By("listing objects for the root account")
+				list := &types.V0045SharesList{}
+				err := cl.List(ctx, list, &ListOptions{
+					Params: api.SlurmV0045GetSharesParams{Accounts: ptr.To("root")},
+				})
+				Expect(err).NotTo(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+	})
+
 	Describe("V0045Stats", func() {
 		var cl Client
 
@@ -642,4 +1628,203 @@ var _ = Describe("Client v0045", func() {
 			}, SpecTimeout(testTimeout))
 		})
 	})
+
+	Describe("V0045Tres", func() {
+		var cl Client
+
+		BeforeEach(func() {
+			var err error
+			cl, err = NewClient(cfg, &ClientOptions{
+				EnableFor: []object.Object{
+					&types.V0045Tres{},
+				},
+				CacheSyncPeriod: cacheSyncPeriod,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cl).NotTo(BeNil())
+
+			go cl.Start(context.TODO())
+
+			DeferCleanup(func() {
+				cl.Stop()
+			})
+		})
+
+		Context("Get", func() {
+			It("should fail if the object does not exist", func(ctx SpecContext) {
+				By("fetching non-existent object")
+				actual := &types.V0045Tres{}
+				err := cl.Get(ctx, "does-not-exist", actual)
+				Expect(err).To(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+			It("should return existing object", func(ctx SpecContext) {
+				By("fetching existent object")
+				actual := &types.V0045Tres{}
+				err := cl.Get(ctx, "cpu", actual)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actual.Id).To(HaveValue(BeEquivalentTo(1)))
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("List", func() {
+			It("should return a list", func(ctx SpecContext) {
+				By("listing all objects")
+				list := &types.V0045TresList{}
+				err := cl.List(ctx, list)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(list.Items).NotTo(BeEmpty())
+
+				By("formatting TRES by id")
+				tres := api.V0045TresList{{Id: ptr.To[int32](1), Count: ptr.To[int64](2)}}
+				Expect(list.Format(tres)).To(Equal("cpu=2"))
+			}, SpecTimeout(testTimeout))
+		})
+	})
+
+	Describe("V0045User", func() {
+		var cl Client
+
+		BeforeEach(func() {
+			var err error
+			cl, err = NewClient(cfg, &ClientOptions{
+				EnableFor: []object.Object{
+					&types.V0045User{},
+				},
+				CacheSyncPeriod: cacheSyncPeriod,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cl).NotTo(BeNil())
+
+			go cl.Start(context.TODO())
+
+			DeferCleanup(func() {
+				cl.Stop()
+			})
+		})
+
+		Context("EnsureUserInAccount", func() {
+			It("should create the user in the account", func(ctx SpecContext) {
+				const userName = "ensure-v45"
+				By("ensuring the user is in the account")
+				obj := &types.V0045User{}
+				err := cl.EnsureUserInAccount(ctx, obj, userName, "root", &EnsureUserOptions{DefaultAccount: true})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(obj.GetKey()).To(BeEquivalentTo(userName))
+				Expect(obj.GetDefaultAccount()).To(Equal("root"))
+
+				By("ensuring again is a no-op")
+				err = cl.EnsureUserInAccount(ctx, obj, userName, "root")
+				Expect(err).NotTo(HaveOccurred())
+
+				By("deleting the object")
+				err = cl.Delete(ctx, obj)
+				Expect(err).NotTo(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("Get", func() {
+			It("should fail if the object does not exist", func(ctx SpecContext) {
+				By("fetching non-existent object")
+				actual := &types.V0045User{}
+				err := cl.Get(ctx, "does-not-exist", actual)
+				Expect(err).To(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+			It("should return existing object", func(ctx SpecContext) {
+				By("fetching existent object")
+				actual := &types.V0045User{}
+				err := cl.Get(ctx, "root", actual)
+				Expect(err).NotTo(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+			It("should return existing object with params", func(ctx SpecContext) {
+				By("fetching existent object with associations")
+				actual := &types.V0045User{}
+				params := &api.SlurmdbV0045GetUserParams{WithAssocs: ptr.To("true")}
+				err := cl.Get(ctx, "root", actual, &GetOptions{Params: params})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actual.GetAccountNames()).NotTo(BeEmpty())
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("List", func() {
+			It("should return a list", func(ctx SpecContext) {
+				By("listing all objects")
+				list := &types.V0045UserList{}
+				err := cl.List(ctx, list)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(list.Items).NotTo(BeEmpty())
+			}, SpecTimeout(testTimeout))
+		})
+	})
+
+	Describe("V0045WCKey", func() {
+		var cl Client
+
+		BeforeEach(func() {
+			var err error
+			cl, err = NewClient(cfg, &ClientOptions{
+				EnableFor: []object.Object{
+					&types.V0045WCKey{},
+				},
+				CacheSyncPeriod: cacheSyncPeriod,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cl).NotTo(BeNil())
+
+			go cl.Start(context.TODO())
+
+			DeferCleanup(func() {
+				cl.Stop()
+			})
+		})
+
+		Context("Create", func() {
+			It("should create and delete an object", func(ctx SpecContext) {
+				By("finding the cluster")
+				clusters := &types.V0045ClusterList{}
+				err := cl.List(ctx, clusters)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(clusters.Items).NotTo(BeEmpty())
+				clusterName := ptr.Deref(clusters.Items[0].Name, "")
+
+				By("creating the object")
+				obj := &types.V0045WCKey{}
+				req := api.V0045Wckey{Cluster: clusterName, User: "root", Name: "create-v45"}
+				err = cl.Create(ctx, obj, req)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(obj.Name).To(Equal(req.Name))
+				Expect(obj.Id).NotTo(BeNil())
+
+				By("deleting the object")
+				err = cl.Delete(ctx, obj)
+				Expect(err).NotTo(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("Delete", func() {
+			It("should fail if the object does not exist", func(ctx SpecContext) {
+				By("deleting the object")
+				obj := &types.V0045WCKey{V0045Wckey: api.V0045Wckey{Id: ptr.To[int32](99999)}}
+				err := cl.Delete(ctx, obj)
+				Expect(err).To(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("Get", func() {
+			It("should fail if the object does not exist", func(ctx SpecContext) {
+				By("fetching non-existent object")
+				actual := &types.V0045WCKey{}
+				err := cl.Get(ctx, "99999", actual)
+				Expect(err).To(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+
+		Context("List", func() {
+			It("should return a list", func(ctx SpecContext) {
+				By("listing all objects")
+				list := &types.V0045WCKeyList{}
+				err := cl.List(ctx, list)
+				Expect(err).NotTo(HaveOccurred())
+			}, SpecTimeout(testTimeout))
+		})
+	})
 })
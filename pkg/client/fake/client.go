@@ -7,13 +7,21 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"slices"
+	"strconv"
+	"strings"
 
+	"k8s.io/utils/ptr"
+
+	v0044 "github.com/SlinkyProject/slurm-client/api/v0044"
+	v0045 "github.com/SlinkyProject/slurm-client/api/v0045"
 	"github.com/SlinkyProject/slurm-client/pkg/client"
 	"github.com/SlinkyProject/slurm-client/pkg/client/interceptor"
 	"github.com/SlinkyProject/slurm-client/pkg/client/token"
 	apierrors "github.com/SlinkyProject/slurm-client/pkg/errors"
 	"github.com/SlinkyProject/slurm-client/pkg/object"
 	"github.com/SlinkyProject/slurm-client/pkg/types"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
 )
 
 type fakeClient struct {
@@ -21,9 +29,10 @@ type fakeClient struct {
 
 	updateFn updateFunc
 
-	server        string
-	authToken     string
-	tokenProvider token.Provider
+	server            string
+	authToken         string
+	tokenProvider     token.Provider
+	supportedVersions []string
 
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -47,10 +56,11 @@ func NewClientBuilder() *ClientBuilder {
 
 // ClientBuilder builds a fake client.
 type ClientBuilder struct {
-	updateFn         updateFunc
-	initLists        []object.ObjectList
-	initObject       []object.Object
-	interceptorFuncs *interceptor.Funcs
+	updateFn          updateFunc
+	initLists         []object.ObjectList
+	initObject        []object.Object
+	interceptorFuncs  *interceptor.Funcs
+	supportedVersions []string
 }
 
 // WithObjects can be optionally used to initialize this fake client with object.Object(s).
@@ -72,6 +82,13 @@ func (f *ClientBuilder) WithUpdateFn(updateFn updateFunc) *ClientBuilder {
 	return f
 }
 
+// WithSupportedVersions configures the versions served by the server, all
+// versions known to the client by default.
+func (f *ClientBuilder) WithSupportedVersions(versions ...string) *ClientBuilder {
+	f.supportedVersions = append(f.supportedVersions, versions...)
+	return f
+}
+
 // WithInterceptorFuncs configures the client methods to be intercepted using the provided interceptor.Funcs.
 func (f *ClientBuilder) WithInterceptorFuncs(interceptorFuncs interceptor.Funcs) *ClientBuilder {
 	f.interceptorFuncs = &interceptorFuncs
@@ -100,11 +117,17 @@ func (f *ClientBuilder) Build() client.Client {
 		cache[objType][obj.GetKey()] = obj.DeepCopyObject()
 	}
 
+	supportedVersions := f.supportedVersions
+	if supportedVersions == nil {
+		supportedVersions = client.KnownVersions()
+	}
+
 	var result client.Client = &fakeClient{
-		updateFn:  f.updateFn,
-		server:    FakeServer,
-		authToken: FakeSecret,
-		cache:     cache,
+		updateFn:          f.updateFn,
+		server:            FakeServer,
+		authToken:         FakeSecret,
+		cache:             cache,
+		supportedVersions: supportedVersions,
 	}
 
 	if f.interceptorFuncs != nil {
@@ -128,6 +151,9 @@ func (c *fakeClient) Get(ctx context.Context, key object.ObjectKey, obj object.O
 	case *types.V0042JobInfo:
 		cache := entry.(*types.V0042JobInfo)
 		*o = *cache
+	case *types.V0042License:
+		cache := entry.(*types.V0042License)
+		*o = *cache
 	case *types.V0042Node:
 		cache := entry.(*types.V0042Node)
 		*o = *cache
@@ -146,6 +172,9 @@ func (c *fakeClient) Get(ctx context.Context, key object.ObjectKey, obj object.O
 	case *types.V0043JobInfo:
 		cache := entry.(*types.V0043JobInfo)
 		*o = *cache
+	case *types.V0043License:
+		cache := entry.(*types.V0043License)
+		*o = *cache
 	case *types.V0043Node:
 		cache := entry.(*types.V0043Node)
 		*o = *cache
@@ -164,6 +193,9 @@ func (c *fakeClient) Get(ctx context.Context, key object.ObjectKey, obj object.O
 	case *types.V0044JobInfo:
 		cache := entry.(*types.V0044JobInfo)
 		*o = *cache
+	case *types.V0044License:
+		cache := entry.(*types.V0044License)
+		*o = *cache
 	case *types.V0044NodeResourceLayout:
 		cache := entry.(*types.V0044NodeResourceLayout)
 		*o = *cache
@@ -182,12 +214,42 @@ func (c *fakeClient) Get(ctx context.Context, key object.ObjectKey, obj object.O
 
 	/////////////////////////////////////////////////////////////////////////////////
 
+	case *types.V0045Account:
+		cache := entry.(*types.V0045Account)
+		*o = *cache
+	case *types.V0045AccountingJob:
+		cache := entry.(*types.V0045AccountingJob)
+		*o = *cache
+	case *types.V0045Association:
+		cache := entry.(*types.V0045Association)
+		*o = *cache
+	case *types.V0045Cluster:
+		cache := entry.(*types.V0045Cluster)
+		*o = *cache
+	case *types.V0045Conf:
+		cache := entry.(*types.V0045Conf)
+		*o = *cache
 	case *types.V0045ControllerPing:
 		cache := entry.(*types.V0045ControllerPing)
 		*o = *cache
+	case *types.V0045DbdPing:
+		cache := entry.(*types.V0045DbdPing)
+		*o = *cache
+	case *types.V0045DbdStats:
+		cache := entry.(*types.V0045DbdStats)
+		*o = *cache
+	case *types.V0045Instance:
+		cache := entry.(*types.V0045Instance)
+		*o = *cache
 	case *types.V0045JobInfo:
 		cache := entry.(*types.V0045JobInfo)
 		*o = *cache
+	case *types.V0045JobState:
+		cache := entry.(*types.V0045JobState)
+		*o = *cache
+	case *types.V0045License:
+		cache := entry.(*types.V0045License)
+		*o = *cache
 	case *types.V0045NodeResourceLayout:
 		cache := entry.(*types.V0045NodeResourceLayout)
 		*o = *cache
@@ -197,12 +259,27 @@ func (c *fakeClient) Get(ctx context.Context, key object.ObjectKey, obj object.O
 	case *types.V0045PartitionInfo:
 		cache := entry.(*types.V0045PartitionInfo)
 		*o = *cache
+	case *types.V0045Qos:
+		cache := entry.(*types.V0045Qos)
+		*o = *cache
 	case *types.V0045ReservationInfo:
 		cache := entry.(*types.V0045ReservationInfo)
 		*o = *cache
+	case *types.V0045Shares:
+		cache := entry.(*types.V0045Shares)
+		*o = *cache
 	case *types.V0045Stats:
 		cache := entry.(*types.V0045Stats)
 		*o = *cache
+	case *types.V0045Tres:
+		cache := entry.(*types.V0045Tres)
+		*o = *cache
+	case *types.V0045User:
+		cache := entry.(*types.V0045User)
+		*o = *cache
+	case *types.V0045WCKey:
+		cache := entry.(*types.V0045WCKey)
+		*o = *cache
 
 	/////////////////////////////////////////////////////////////////////////////////
 
@@ -261,6 +338,211 @@ func (c *fakeClient) Update(ctx context.Context, obj object.Object, req any, opt
 	return nil
 }
 
+func (c *fakeClient) UpdateNodes(ctx context.Context, list object.ObjectList, nodeNames []string, req any, opts ...client.UpdateOption) error {
+	t := list.GetType()
+	objs := []object.Object{}
+	for _, nodeName := range nodeNames {
+		hosts, err := utils.ExpandHostlist(nodeName)
+		if err != nil {
+			return err
+		}
+		for _, host := range hosts {
+			obj, ok := c.cache[t][object.ObjectKey(host)]
+			if !ok {
+				return apierrors.ErrObjectNotFound
+			}
+			objs = append(objs, obj)
+		}
+	}
+	for _, obj := range objs {
+		if c.updateFn != nil {
+			if err := c.updateFn(ctx, obj, req, opts...); err != nil {
+				return err
+			}
+		}
+		list.AppendItem(obj.DeepCopyObject())
+	}
+	return nil
+}
+
+func (c *fakeClient) CreateOrUpdateReservations(ctx context.Context, list object.ObjectList, req any, opts ...client.UpdateOption) ([]client.ReservationResult, error) {
+	objs := []object.Object{}
+	switch r := req.(type) {
+	case v0044.V0044ReservationModReq:
+		for _, item := range ptr.Deref(r.Reservations, nil) {
+			obj := &types.V0044ReservationInfo{}
+			utils.RemarshalOrDie(item, obj)
+			objs = append(objs, obj)
+		}
+	case v0045.V0045ReservationModReq:
+		for _, item := range ptr.Deref(r.Reservations, nil) {
+			obj := &types.V0045ReservationInfo{}
+			utils.RemarshalOrDie(item, obj)
+			objs = append(objs, obj)
+		}
+	default:
+		return nil, errors.New(http.StatusText(http.StatusBadRequest))
+	}
+	results := []client.ReservationResult{}
+	for _, obj := range objs {
+		t := obj.GetType()
+		if _, ok := c.cache[t]; !ok {
+			c.cache[t] = make(map[object.ObjectKey]object.Object)
+		}
+		c.cache[t][obj.GetKey()] = obj
+		list.AppendItem(obj.DeepCopyObject())
+		results = append(results, client.ReservationResult{Name: string(obj.GetKey())})
+	}
+	return results, nil
+}
+
+func (c *fakeClient) RequeueJob(ctx context.Context, obj object.Object, opts ...client.RequeueOption) ([]client.JobResult, error) {
+	t := obj.GetType()
+	k := obj.GetKey()
+	if _, ok := c.cache[t][k]; !ok {
+		return nil, apierrors.ErrObjectNotFound
+	}
+	return []client.JobResult{newJobResult(k, nil)}, nil
+}
+
+func (c *fakeClient) RequeueJobs(ctx context.Context, list object.ObjectList, opts ...client.RequeueOption) ([]client.JobResult, error) {
+	t := object.ObjectType(strings.TrimSuffix(string(list.GetType()), "List"))
+	results := []client.JobResult{}
+	for _, item := range list.GetItems() {
+		k := item.GetKey()
+		var err error
+		if _, ok := c.cache[t][k]; !ok {
+			err = apierrors.ErrObjectNotFound
+		}
+		results = append(results, newJobResult(k, err))
+	}
+	return results, nil
+}
+
+func (c *fakeClient) KillJobs(ctx context.Context, obj object.Object, opts ...client.KillJobsOption) ([]client.JobResult, error) {
+	options := &client.KillJobsOptions{}
+	options.ApplyOptions(opts)
+	if !options.HasFilter() {
+		return nil, errors.New("at least one job filter must be set")
+	}
+	t := obj.GetType()
+	results := []client.JobResult{}
+	for k, o := range c.cache[t] {
+		if !matchesKillJobs(o, options) {
+			continue
+		}
+		results = append(results, newJobResult(k, nil))
+		// Only the default signal cancels the job
+		if options.Signal == "" {
+			delete(c.cache[t], k)
+		}
+	}
+	return results, nil
+}
+
+// matchesKillJobs returns true if the job matches every filter of options.
+func matchesKillJobs(obj object.Object, options *client.KillJobsOptions) bool {
+	if len(options.JobIds) > 0 && !slices.Contains(options.JobIds, string(obj.GetKey())) {
+		return false
+	}
+	job, ok := obj.(*types.V0045JobInfo)
+	if !ok {
+		return false
+	}
+	matches := func(filter string, value *string) bool {
+		return filter == "" || filter == ptr.Deref(value, "")
+	}
+	if !matches(options.Account, job.Account) ||
+		!matches(options.UserName, job.UserName) ||
+		!matches(options.Partition, job.Partition) ||
+		!matches(options.Qos, job.Qos) ||
+		!matches(options.Reservation, job.ResvName) ||
+		!matches(options.JobName, job.Name) {
+		return false
+	}
+	if len(options.JobState) > 0 {
+		found := false
+		for state := range job.GetStateAsSet() {
+			if slices.Contains(options.JobState, string(state)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func newJobResult(key object.ObjectKey, err error) client.JobResult {
+	jobId, _ := strconv.ParseInt(string(key), 10, 32)
+	return client.JobResult{
+		JobId: int32(jobId),
+		Error: err,
+	}
+}
+
+func (c *fakeClient) EnsureUserInAccount(ctx context.Context, obj object.Object, userName, account string, opts ...client.EnsureUserOption) error {
+	options := &client.EnsureUserOptions{}
+	options.ApplyOptions(opts)
+	t := obj.GetType()
+	k := object.ObjectKey(userName)
+	if _, ok := c.cache[t]; !ok {
+		c.cache[t] = make(map[object.ObjectKey]object.Object)
+	}
+	switch o := obj.(type) {
+	case *types.V0045User:
+		user, ok := c.cache[t][k].(*types.V0045User)
+		if !ok {
+			user = &types.V0045User{V0045User: v0045.V0045User{Name: userName}}
+		}
+		clusters := options.Clusters
+		if len(clusters) == 0 {
+			clusters = []string{""}
+		}
+		partitions := options.Partitions
+		if len(partitions) == 0 {
+			partitions = []string{""}
+		}
+		for _, cluster := range clusters {
+			for _, partition := range partitions {
+				if user.HasAssociation(account, cluster, partition) {
+					continue
+				}
+				assocs := ptr.Deref(user.Associations, v0045.V0045AssocShortList{})
+				assocs = append(assocs, v0045.V0045AssocShort{
+					User:      userName,
+					Account:   ptr.To(account),
+					Cluster:   utils.StringPtrOrNil(cluster),
+					Partition: utils.StringPtrOrNil(partition),
+				})
+				user.Associations = &assocs
+			}
+		}
+		if options.DefaultAccount {
+			utils.RemarshalOrDie(map[string]any{"default": map[string]any{"account": account}}, &user.V0045User)
+		}
+		c.cache[t][k] = user
+		*o = *user.DeepCopy()
+	default:
+		return apierrors.ErrNotImplemented
+	}
+	return nil
+}
+
+func (c *fakeClient) DiscoverVersions(ctx context.Context) error {
+	return nil
+}
+
+func (c *fakeClient) SupportedVersions() []string {
+	return slices.Clone(c.supportedVersions)
+}
+
+func (c *fakeClient) PreferredVersion(versions ...string) (string, error) {
+	return client.PreferredVersion(c.supportedVersions, versions...)
+}
+
 func (c *fakeClient) GetInformer(obj object.ObjectType) client.InformerCache {
 	return newInformer(obj, c, client.DefaultWatchInterval)
 }
@@ -14,6 +14,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	v0042 "github.com/SlinkyProject/slurm-client/api/v0042"
+	v0045 "github.com/SlinkyProject/slurm-client/api/v0045"
 	"github.com/SlinkyProject/slurm-client/pkg/client"
 	"github.com/SlinkyProject/slurm-client/pkg/client/interceptor"
 	"github.com/SlinkyProject/slurm-client/pkg/client/token"
@@ -198,6 +199,286 @@ var _ = Describe("NewFakeClient", func() {
 		})
 	})
 
+	Context("UpdateNodes", func() {
+		It("should update existing objects", func() {
+			comment := "test update nodes"
+			obj1 := &types.V0045Node{V0045Node: v0045.V0045Node{Name: ptr.To("node-0")}}
+			obj2 := &types.V0045Node{V0045Node: v0045.V0045Node{Name: ptr.To("node-1")}}
+			updateFn := func(ctx context.Context, obj object.Object, req any, opts ...client.UpdateOption) error {
+				o, ok := obj.(*types.V0045Node)
+				if !ok {
+					return errors.New("failed to cast slurm object")
+				}
+				r, ok := req.(v0045.V0045UpdateNodeMsg)
+				if !ok {
+					return errors.New("failed to cast request object")
+				}
+				o.Comment = r.Comment
+				return nil
+			}
+			client := NewClientBuilder().WithObjects(obj1, obj2).WithUpdateFn(updateFn).Build()
+			list := &types.V0045NodeList{}
+			req := v0045.V0045UpdateNodeMsg{Comment: &comment}
+			err := client.UpdateNodes(ctx, list, []string{"node-[0-1]"}, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(list.Items).To(HaveLen(2))
+			for _, node := range list.Items {
+				Expect(node.Comment).To(BeEquivalentTo(&comment))
+			}
+		})
+		It("should return Not Found", func() {
+			obj := &types.V0045Node{V0045Node: v0045.V0045Node{Name: ptr.To("node-0")}}
+			client := NewClientBuilder().WithObjects(obj).Build()
+			list := &types.V0045NodeList{}
+			req := v0045.V0045UpdateNodeMsg{}
+			err := client.UpdateNodes(ctx, list, []string{"node-[0-1]"}, req)
+			Expect(err).To(HaveOccurred())
+		})
+		It("should return error", func() {
+			client := NewClientBuilder().
+				WithInterceptorFuncs(interceptor.Funcs{
+					UpdateNodes: func(ctx context.Context, list object.ObjectList, nodeNames []string, req any, opts ...client.UpdateOption) error {
+						return errors.New(http.StatusText(http.StatusInternalServerError))
+					},
+				}).
+				Build()
+			list := &types.V0045NodeList{}
+			req := v0045.V0045UpdateNodeMsg{}
+			err := client.UpdateNodes(ctx, list, []string{"node-0"}, req)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("CreateOrUpdateReservations", func() {
+		It("should create all objects", func() {
+			client := NewFakeClient()
+			list := &types.V0045ReservationInfoList{}
+			req := v0045.V0045ReservationModReq{
+				Reservations: &v0045.V0045ReservationDescMsgList{
+					{Name: ptr.To("foo")},
+					{Name: ptr.To("bar")},
+				},
+			}
+			results, err := client.CreateOrUpdateReservations(ctx, list, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results).To(HaveLen(2))
+			Expect(list.Items).To(HaveLen(2))
+
+			By("validating the objects were created")
+			err = client.Get(ctx, "foo", &types.V0045ReservationInfo{})
+			Expect(err).NotTo(HaveOccurred())
+		})
+		It("should return error", func() {
+			client := NewClientBuilder().
+				WithInterceptorFuncs(interceptor.Funcs{
+					CreateOrUpdateReservations: func(ctx context.Context, list object.ObjectList, req any, opts ...client.UpdateOption) ([]client.ReservationResult, error) {
+						return nil, errors.New(http.StatusText(http.StatusInternalServerError))
+					},
+				}).
+				Build()
+			list := &types.V0045ReservationInfoList{}
+			_, err := client.CreateOrUpdateReservations(ctx, list, v0045.V0045ReservationModReq{})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("EnsureUserInAccount", func() {
+		It("should create the user", func() {
+			client := NewFakeClient()
+			obj := &types.V0045User{}
+			err := client.EnsureUserInAccount(ctx, obj, "foo", "bar")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(obj.Name).To(Equal("foo"))
+			Expect(obj.GetAccountNames()).To(Equal([]string{"bar"}))
+
+			By("validating the object was created")
+			err = client.Get(ctx, "foo", &types.V0045User{})
+			Expect(err).NotTo(HaveOccurred())
+		})
+		It("should add the account to an existing user", func() {
+			user := &types.V0045User{V0045User: v0045.V0045User{
+				Name:         "foo",
+				Associations: &v0045.V0045AssocShortList{{User: "foo", Account: ptr.To("baz")}},
+			}}
+			opts := &client.EnsureUserOptions{DefaultAccount: true}
+			client := NewClientBuilder().WithObjects(user).Build()
+			obj := &types.V0045User{}
+			err := client.EnsureUserInAccount(ctx, obj, "foo", "bar", opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(obj.GetAccountNames()).To(Equal([]string{"baz", "bar"}))
+			Expect(obj.GetDefaultAccount()).To(Equal("bar"))
+		})
+		It("should add a partition association next to an account-wide one", func() {
+			user := &types.V0045User{V0045User: v0045.V0045User{
+				Name:         "foo",
+				Associations: &v0045.V0045AssocShortList{{User: "foo", Account: ptr.To("bar")}},
+			}}
+			opts := &client.EnsureUserOptions{Partitions: []string{"debug"}}
+			client := NewClientBuilder().WithObjects(user).Build()
+			obj := &types.V0045User{}
+			err := client.EnsureUserInAccount(ctx, obj, "foo", "bar", opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(obj.HasAssociation("bar", "", "")).To(BeTrue())
+			Expect(obj.HasAssociation("bar", "", "debug")).To(BeTrue())
+			Expect(*obj.Associations).To(HaveLen(2))
+		})
+		It("should return error", func() {
+			client := NewClientBuilder().
+				WithInterceptorFuncs(interceptor.Funcs{
+					EnsureUserInAccount: func(ctx context.Context, obj object.Object, userName, account string, opts ...client.EnsureUserOption) error {
+						return errors.New(http.StatusText(http.StatusInternalServerError))
+					},
+				}).
+				Build()
+			err := client.EnsureUserInAccount(ctx, &types.V0045User{}, "foo", "bar")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("RequeueJob", func() {
+		It("should succeed", func() {
+			obj := &types.V0045JobInfo{V0045JobInfo: v0045.V0045JobInfo{JobId: ptr.To[int32](1)}}
+			client := NewClientBuilder().WithObjects(obj).Build()
+			results, err := client.RequeueJob(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results).To(HaveLen(1))
+			Expect(results[0].JobId).To(Equal(int32(1)))
+		})
+		It("should return Not Found", func() {
+			client := NewFakeClient()
+			obj := &types.V0045JobInfo{V0045JobInfo: v0045.V0045JobInfo{JobId: ptr.To[int32](1)}}
+			_, err := client.RequeueJob(ctx, obj)
+			Expect(err).To(HaveOccurred())
+		})
+		It("should return error", func() {
+			client := NewClientBuilder().
+				WithInterceptorFuncs(interceptor.Funcs{
+					RequeueJob: func(ctx context.Context, obj object.Object, opts ...client.RequeueOption) ([]client.JobResult, error) {
+						return nil, errors.New(http.StatusText(http.StatusInternalServerError))
+					},
+				}).
+				Build()
+			obj := &types.V0045JobInfo{V0045JobInfo: v0045.V0045JobInfo{JobId: ptr.To[int32](1)}}
+			_, err := client.RequeueJob(ctx, obj)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("RequeueJobs", func() {
+		It("should report results per job", func() {
+			obj := &types.V0045JobInfo{V0045JobInfo: v0045.V0045JobInfo{JobId: ptr.To[int32](1)}}
+			client := NewClientBuilder().WithObjects(obj).Build()
+			list := &types.V0045JobInfoList{
+				Items: []types.V0045JobInfo{
+					{V0045JobInfo: v0045.V0045JobInfo{JobId: ptr.To[int32](1)}},
+					{V0045JobInfo: v0045.V0045JobInfo{JobId: ptr.To[int32](2)}},
+				},
+			}
+			results, err := client.RequeueJobs(ctx, list)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results).To(HaveLen(2))
+			Expect(results[0].Error).NotTo(HaveOccurred())
+			Expect(results[1].Error).To(HaveOccurred())
+		})
+		It("should return error", func() {
+			client := NewClientBuilder().
+				WithInterceptorFuncs(interceptor.Funcs{
+					RequeueJobs: func(ctx context.Context, list object.ObjectList, opts ...client.RequeueOption) ([]client.JobResult, error) {
+						return nil, errors.New(http.StatusText(http.StatusInternalServerError))
+					},
+				}).
+				Build()
+			list := &types.V0045JobInfoList{}
+			_, err := client.RequeueJobs(ctx, list)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("KillJobs", func() {
+		It("should cancel matching jobs", func() {
+			obj1 := &types.V0045JobInfo{V0045JobInfo: v0045.V0045JobInfo{JobId: ptr.To[int32](1)}}
+			obj2 := &types.V0045JobInfo{V0045JobInfo: v0045.V0045JobInfo{JobId: ptr.To[int32](2)}}
+			opts := &client.KillJobsOptions{JobIds: []string{"1"}}
+			client := NewClientBuilder().WithObjects(obj1, obj2).Build()
+			results, err := client.KillJobs(ctx, &types.V0045JobInfo{}, opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results).To(HaveLen(1))
+			Expect(results[0].JobId).To(Equal(int32(1)))
+
+			By("validating the remaining jobs")
+			list := &types.V0045JobInfoList{}
+			err = client.List(ctx, list)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(list.Items).To(HaveLen(1))
+		})
+		It("should signal matching jobs without cancelling them", func() {
+			obj := &types.V0045JobInfo{V0045JobInfo: v0045.V0045JobInfo{JobId: ptr.To[int32](1)}}
+			opts := &client.KillJobsOptions{Signal: "SIGUSR1", JobIds: []string{"1"}}
+			client := NewClientBuilder().WithObjects(obj).Build()
+			results, err := client.KillJobs(ctx, &types.V0045JobInfo{}, opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results).To(HaveLen(1))
+
+			By("validating the job was not removed")
+			err = client.Get(ctx, obj.GetKey(), &types.V0045JobInfo{})
+			Expect(err).NotTo(HaveOccurred())
+		})
+		It("should cancel jobs matching every filter", func() {
+			obj1 := &types.V0045JobInfo{V0045JobInfo: v0045.V0045JobInfo{
+				JobId:     ptr.To[int32](1),
+				Account:   ptr.To("foo"),
+				Partition: ptr.To("debug"),
+				JobState:  &[]v0045.V0045JobInfoJobState{v0045.V0045JobInfoJobStatePENDING},
+			}}
+			obj2 := &types.V0045JobInfo{V0045JobInfo: v0045.V0045JobInfo{
+				JobId:     ptr.To[int32](2),
+				Account:   ptr.To("foo"),
+				Partition: ptr.To("batch"),
+				JobState:  &[]v0045.V0045JobInfoJobState{v0045.V0045JobInfoJobStatePENDING},
+			}}
+			obj3 := &types.V0045JobInfo{V0045JobInfo: v0045.V0045JobInfo{
+				JobId:     ptr.To[int32](3),
+				Account:   ptr.To("foo"),
+				Partition: ptr.To("debug"),
+				JobState:  &[]v0045.V0045JobInfoJobState{v0045.V0045JobInfoJobStateRUNNING},
+			}}
+			opts := &client.KillJobsOptions{Account: "foo", Partition: "debug", JobState: []string{"PENDING"}}
+			client := NewClientBuilder().WithObjects(obj1, obj2, obj3).Build()
+			results, err := client.KillJobs(ctx, &types.V0045JobInfo{}, opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results).To(HaveLen(1))
+			Expect(results[0].JobId).To(Equal(int32(1)))
+
+			By("validating the remaining jobs")
+			list := &types.V0045JobInfoList{}
+			err = client.List(ctx, list)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(list.Items).To(HaveLen(2))
+		})
+		It("should reject a request without filters", func() {
+			obj := &types.V0045JobInfo{V0045JobInfo: v0045.V0045JobInfo{JobId: ptr.To[int32](1)}}
+			opts := &client.KillJobsOptions{Signal: "SIGTERM"}
+			client := NewClientBuilder().WithObjects(obj).Build()
+			_, err := client.KillJobs(ctx, &types.V0045JobInfo{}, opts)
+			Expect(err).To(HaveOccurred())
+
+			By("validating the job was not removed")
+			err = client.Get(ctx, obj.GetKey(), &types.V0045JobInfo{})
+			Expect(err).NotTo(HaveOccurred())
+		})
+		It("should return error", func() {
+			client := NewClientBuilder().
+				WithInterceptorFuncs(interceptor.Funcs{
+					KillJobs: func(ctx context.Context, obj object.Object, opts ...client.KillJobsOption) ([]client.JobResult, error) {
+						return nil, errors.New(http.StatusText(http.StatusInternalServerError))
+					},
+				}).
+				Build()
+			_, err := client.KillJobs(ctx, &types.V0045JobInfo{})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
 	// Context("GetInformer", func() {
 	// 	It("should return informer", func() {
 	// 		client := NewFakeClient()
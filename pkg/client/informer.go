@@ -22,6 +22,10 @@ import (
 const (
 	defaultSyncPeriod = 30 * time.Second
 	batchPeriod       = 1 * time.Second
+
+	// jobStateMaxGets bounds the JobInfo fetched one by one during a job state
+	// sync, above which all JobInfo are listed instead.
+	jobStateMaxGets = 64
 )
 
 type cacheEntry struct {
@@ -71,6 +75,9 @@ type informerCache struct {
 
 	// syncPeriod is the frequency to run the informer.
 	syncPeriod time.Duration
+
+	// jobStateSync indicates to list through V0045JobState, see doJobStateListInformer.
+	jobStateSync bool
 }
 
 // SetEventHandler implements InformerCache.
@@ -144,6 +151,11 @@ func (i *informerCache) doListInformer() {
 	i.dirty = true
 	i.mu.Unlock()
 
+	if i.jobStateSync {
+		i.doJobStateListInformer()
+		return
+	}
+
 	var list object.ObjectList
 	switch i.objectType {
 	/////////////////////////////////////////////////////////////////////////////////
@@ -152,6 +164,8 @@ func (i *informerCache) doListInformer() {
 		list = &types.V0042ControllerPingList{}
 	case types.ObjectTypeV0042JobInfo:
 		list = &types.V0042JobInfoList{}
+	case types.ObjectTypeV0042License:
+		list = &types.V0042LicenseList{}
 	case types.ObjectTypeV0042Node:
 		list = &types.V0042NodeList{}
 	case types.ObjectTypeV0042PartitionInfo:
@@ -167,6 +181,8 @@ func (i *informerCache) doListInformer() {
 		list = &types.V0043ControllerPingList{}
 	case types.ObjectTypeV0043JobInfo:
 		list = &types.V0043JobInfoList{}
+	case types.ObjectTypeV0043License:
+		list = &types.V0043LicenseList{}
 	case types.ObjectTypeV0043Node:
 		list = &types.V0043NodeList{}
 	case types.ObjectTypeV0043PartitionInfo:
@@ -182,6 +198,8 @@ func (i *informerCache) doListInformer() {
 		list = &types.V0044ControllerPingList{}
 	case types.ObjectTypeV0044JobInfo:
 		list = &types.V0044JobInfoList{}
+	case types.ObjectTypeV0044License:
+		list = &types.V0044LicenseList{}
 	case types.ObjectTypeV0044Node:
 		list = &types.V0044NodeList{}
 	case types.ObjectTypeV0044PartitionInfo:
@@ -197,22 +215,52 @@ func (i *informerCache) doListInformer() {
 
 	/////////////////////////////////////////////////////////////////////////////////
 
+	case types.ObjectTypeV0045Account:
+		list = &types.V0045AccountList{}
+	case types.ObjectTypeV0045AccountingJob:
+		panic("AccountingJob is not supported, this scenario should have been avoided.")
+	case types.ObjectTypeV0045Association:
+		list = &types.V0045AssociationList{}
+	case types.ObjectTypeV0045Cluster:
+		list = &types.V0045ClusterList{}
+	case types.ObjectTypeV0045Conf:
+		list = &types.V0045ConfList{}
 	case types.ObjectTypeV0045ControllerPing:
 		list = &types.V0045ControllerPingList{}
+	case types.ObjectTypeV0045DbdPing:
+		list = &types.V0045DbdPingList{}
+	case types.ObjectTypeV0045DbdStats:
+		list = &types.V0045DbdStatsList{}
+	case types.ObjectTypeV0045Instance:
+		list = &types.V0045InstanceList{}
 	case types.ObjectTypeV0045JobInfo:
 		list = &types.V0045JobInfoList{}
+	case types.ObjectTypeV0045JobState:
+		list = &types.V0045JobStateList{}
+	case types.ObjectTypeV0045License:
+		list = &types.V0045LicenseList{}
 	case types.ObjectTypeV0045Node:
 		list = &types.V0045NodeList{}
 	case types.ObjectTypeV0045PartitionInfo:
 		list = &types.V0045PartitionInfoList{}
+	case types.ObjectTypeV0045Qos:
+		list = &types.V0045QosList{}
 	case types.ObjectTypeV0045Reconfigure:
 		panic("Reconfigure is not supported, this scenario should have been avoided.")
 	case types.ObjectTypeV0045ReservationInfo:
 		list = &types.V0045ReservationInfoList{}
+	case types.ObjectTypeV0045Shares:
+		list = &types.V0045SharesList{}
 	case types.ObjectTypeV0045NodeResourceLayout:
 		panic("NodeResouceLayout is not supported, this scenario should have been avoided.")
 	case types.ObjectTypeV0045Stats:
 		list = &types.V0045StatsList{}
+	case types.ObjectTypeV0045Tres:
+		list = &types.V0045TresList{}
+	case types.ObjectTypeV0045User:
+		list = &types.V0045UserList{}
+	case types.ObjectTypeV0045WCKey:
+		list = &types.V0045WCKeyList{}
 
 	/////////////////////////////////////////////////////////////////////////////////
 
@@ -234,6 +282,75 @@ func (i *informerCache) doListInformer() {
 	i.mu.Unlock()
 }
 
+// doJobStateListInformer syncs the V0045JobInfo cache from the lightweight
+// V0045JobState list. The full JobInfo is only fetched for jobs that are new,
+// dirty, or whose state differs from the cached JobInfo. When the cache is
+// empty, or too many jobs need fetching, all JobInfo are listed at once instead.
+func (i *informerCache) doJobStateListInformer() {
+	ctx := context.TODO()
+
+	states := &types.V0045JobStateList{}
+	err := i.reader.List(ctx, states, &ListOptions{SkipCache: true})
+	if err != nil {
+		i.mu.Lock()
+		i.syncErrorList = err
+		i.mu.Unlock()
+		return
+	}
+
+	list := &types.V0045JobInfoList{}
+	stale := []object.ObjectKey{}
+	i.mu.RLock()
+	for _, state := range states.Items {
+		key := state.GetKey()
+		entry := i.cache[key]
+		if entry != nil && entry.object != nil && !entry.dirty {
+			cached := entry.object.(*types.V0045JobInfo)
+			if cached.GetStateAsSet().Equal(state.GetStateAsSet()) {
+				list.Items = append(list.Items, *cached)
+				continue
+			}
+		}
+		stale = append(stale, key)
+	}
+	cold := len(i.cache) == 0
+	i.mu.RUnlock()
+
+	if cold || len(stale) > jobStateMaxGets || 2*len(stale) > len(states.Items) {
+		jobs := &types.V0045JobInfoList{}
+		err := i.reader.List(ctx, jobs, &ListOptions{SkipCache: true})
+		i.mu.Lock()
+		i.syncErrorList = err
+		if err == nil {
+			i.processObjects(jobs)
+			i.dirty = false
+		}
+		i.mu.Unlock()
+		return
+	}
+
+	for _, key := range stale {
+		job := &types.V0045JobInfo{}
+		err := i.reader.Get(ctx, key, job, &GetOptions{SkipCache: true})
+		if errors.Is(err, apierrors.ErrObjectNotFound) {
+			// The job was purged between the list and the get.
+			continue
+		} else if err != nil {
+			i.mu.Lock()
+			i.syncErrorList = err
+			i.mu.Unlock()
+			return
+		}
+		list.Items = append(list.Items, *job)
+	}
+
+	i.mu.Lock()
+	i.syncErrorList = nil
+	i.processObjects(list)
+	i.dirty = false
+	i.mu.Unlock()
+}
+
 func (i *informerCache) runGetInformer(stopCh <-chan struct{}) {
 	batchTimer := time.NewTimer(batchPeriod)
 	defer batchTimer.Stop()
@@ -285,6 +402,8 @@ func (i *informerCache) doGetInformer(key object.ObjectKey) {
 		obj = &types.V0042ControllerPing{}
 	case types.ObjectTypeV0042JobInfo:
 		obj = &types.V0042JobInfo{}
+	case types.ObjectTypeV0042License:
+		obj = &types.V0042License{}
 	case types.ObjectTypeV0042Node:
 		obj = &types.V0042Node{}
 	case types.ObjectTypeV0042PartitionInfo:
@@ -300,6 +419,8 @@ func (i *informerCache) doGetInformer(key object.ObjectKey) {
 		obj = &types.V0043ControllerPing{}
 	case types.ObjectTypeV0043JobInfo:
 		obj = &types.V0043JobInfo{}
+	case types.ObjectTypeV0043License:
+		obj = &types.V0043License{}
 	case types.ObjectTypeV0043Node:
 		obj = &types.V0043Node{}
 	case types.ObjectTypeV0043PartitionInfo:
@@ -315,6 +436,8 @@ func (i *informerCache) doGetInformer(key object.ObjectKey) {
 		obj = &types.V0044ControllerPing{}
 	case types.ObjectTypeV0044JobInfo:
 		obj = &types.V0044JobInfo{}
+	case types.ObjectTypeV0044License:
+		obj = &types.V0044License{}
 	case types.ObjectTypeV0044Node:
 		obj = &types.V0044Node{}
 	case types.ObjectTypeV0044PartitionInfo:
@@ -328,20 +451,50 @@ func (i *informerCache) doGetInformer(key object.ObjectKey) {
 
 	/////////////////////////////////////////////////////////////////////////////////
 
+	case types.ObjectTypeV0045Account:
+		obj = &types.V0045Account{}
+	case types.ObjectTypeV0045AccountingJob:
+		panic("AccountingJob is not supported, this scenario should have been avoided.")
+	case types.ObjectTypeV0045Association:
+		obj = &types.V0045Association{}
+	case types.ObjectTypeV0045Cluster:
+		obj = &types.V0045Cluster{}
+	case types.ObjectTypeV0045Conf:
+		obj = &types.V0045Conf{}
 	case types.ObjectTypeV0045ControllerPing:
 		obj = &types.V0045ControllerPing{}
+	case types.ObjectTypeV0045DbdPing:
+		obj = &types.V0045DbdPing{}
+	case types.ObjectTypeV0045DbdStats:
+		obj = &types.V0045DbdStats{}
+	case types.ObjectTypeV0045Instance:
+		obj = &types.V0045Instance{}
 	case types.ObjectTypeV0045JobInfo:
 		obj = &types.V0045JobInfo{}
+	case types.ObjectTypeV0045JobState:
+		obj = &types.V0045JobState{}
+	case types.ObjectTypeV0045License:
+		obj = &types.V0045License{}
 	case types.ObjectTypeV0045Node:
 		obj = &types.V0045Node{}
 	case types.ObjectTypeV0045PartitionInfo:
 		obj = &types.V0045PartitionInfo{}
+	case types.ObjectTypeV0045Qos:
+		obj = &types.V0045Qos{}
 	case types.ObjectTypeV0045Reconfigure:
 		panic("Reconfigure is not supported, this scenario should have been avoided.")
 	case types.ObjectTypeV0045ReservationInfo:
 		obj = &types.V0045ReservationInfo{}
+	case types.ObjectTypeV0045Shares:
+		obj = &types.V0045Shares{}
 	case types.ObjectTypeV0045Stats:
 		obj = &types.V0045Stats{}
+	case types.ObjectTypeV0045Tres:
+		obj = &types.V0045Tres{}
+	case types.ObjectTypeV0045User:
+		obj = &types.V0045User{}
+	case types.ObjectTypeV0045WCKey:
+		obj = &types.V0045WCKey{}
 
 	/////////////////////////////////////////////////////////////////////////////////
 
@@ -565,6 +718,9 @@ func (i *informerCache) Get(ctx context.Context, key object.ObjectKey, obj objec
 	case *types.V0042JobInfo:
 		cache := entry.object.(*types.V0042JobInfo)
 		*o = *cache
+	case *types.V0042License:
+		cache := entry.object.(*types.V0042License)
+		*o = *cache
 	case *types.V0042Node:
 		cache := entry.object.(*types.V0042Node)
 		*o = *cache
@@ -583,6 +739,9 @@ func (i *informerCache) Get(ctx context.Context, key object.ObjectKey, obj objec
 	case *types.V0043JobInfo:
 		cache := entry.object.(*types.V0043JobInfo)
 		*o = *cache
+	case *types.V0043License:
+		cache := entry.object.(*types.V0043License)
+		*o = *cache
 	case *types.V0043Node:
 		cache := entry.object.(*types.V0043Node)
 		*o = *cache
@@ -601,6 +760,9 @@ func (i *informerCache) Get(ctx context.Context, key object.ObjectKey, obj objec
 	case *types.V0044JobInfo:
 		cache := entry.object.(*types.V0044JobInfo)
 		*o = *cache
+	case *types.V0044License:
+		cache := entry.object.(*types.V0044License)
+		*o = *cache
 	case *types.V0044Node:
 		cache := entry.object.(*types.V0044Node)
 		*o = *cache
@@ -616,24 +778,66 @@ func (i *informerCache) Get(ctx context.Context, key object.ObjectKey, obj objec
 
 	/////////////////////////////////////////////////////////////////////////////////
 
+	case *types.V0045Account:
+		cache := entry.object.(*types.V0045Account)
+		*o = *cache
+	case *types.V0045Association:
+		cache := entry.object.(*types.V0045Association)
+		*o = *cache
+	case *types.V0045Cluster:
+		cache := entry.object.(*types.V0045Cluster)
+		*o = *cache
+	case *types.V0045Conf:
+		cache := entry.object.(*types.V0045Conf)
+		*o = *cache
 	case *types.V0045ControllerPing:
 		cache := entry.object.(*types.V0045ControllerPing)
 		*o = *cache
+	case *types.V0045DbdPing:
+		cache := entry.object.(*types.V0045DbdPing)
+		*o = *cache
+	case *types.V0045DbdStats:
+		cache := entry.object.(*types.V0045DbdStats)
+		*o = *cache
+	case *types.V0045Instance:
+		cache := entry.object.(*types.V0045Instance)
+		*o = *cache
 	case *types.V0045JobInfo:
 		cache := entry.object.(*types.V0045JobInfo)
 		*o = *cache
+	case *types.V0045JobState:
+		cache := entry.object.(*types.V0045JobState)
+		*o = *cache
+	case *types.V0045License:
+		cache := entry.object.(*types.V0045License)
+		*o = *cache
 	case *types.V0045Node:
 		cache := entry.object.(*types.V0045Node)
 		*o = *cache
 	case *types.V0045PartitionInfo:
 		cache := entry.object.(*types.V0045PartitionInfo)
 		*o = *cache
+	case *types.V0045Qos:
+		cache := entry.object.(*types.V0045Qos)
+		*o = *cache
 	case *types.V0045ReservationInfo:
 		cache := entry.object.(*types.V0045ReservationInfo)
 		*o = *cache
+	case *types.V0045Shares:
+		cache := entry.object.(*types.V0045Shares)
+		*o = *cache
 	case *types.V0045Stats:
 		cache := entry.object.(*types.V0045Stats)
 		*o = *cache
+	case *types.V0045Tres:
+		cache := entry.object.(*types.V0045Tres)
+		*o = *cache
+	case *types.V0045User:
+		cache := entry.object.(*types.V0045User)
+		*o = *cache
+	case *types.V0045WCKey:
+		cache := entry.object.(*types.V0045WCKey)
+		*o = *cache
 
 	/////////////////////////////////////////////////////////////////////////////////
 
@@ -6,6 +6,7 @@ package client
 import (
 	"context"
 	"errors"
+	"reflect"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -13,8 +14,10 @@ import (
 	"k8s.io/utils/ptr"
 
 	api "github.com/SlinkyProject/slurm-client/api/v0044"
+	v0045 "github.com/SlinkyProject/slurm-client/api/v0045"
 	"github.com/SlinkyProject/slurm-client/pkg/cache"
 	"github.com/SlinkyProject/slurm-client/pkg/client/token"
+	apierrors "github.com/SlinkyProject/slurm-client/pkg/errors"
 	"github.com/SlinkyProject/slurm-client/pkg/object"
 	"github.com/SlinkyProject/slurm-client/pkg/types"
 )
@@ -519,6 +522,137 @@ func Test_informerCache_ListRefreshCacheWithFullSyncQueue(t *testing.T) {
 	}
 }
 
+func Test_informerCache_doJobStateListInformer(t *testing.T) {
+	job := func(id int32, state v0045.V0045JobInfoJobState) *types.V0045JobInfo {
+		return &types.V0045JobInfo{
+			V0045JobInfo: v0045.V0045JobInfo{
+				JobId:    ptr.To(id),
+				JobState: &[]v0045.V0045JobInfoJobState{state},
+				Comment:  ptr.To("full"),
+			},
+		}
+	}
+	jobState := func(id int32, state v0045.V0045JobInfoJobState) types.V0045JobState {
+		return types.V0045JobState{JobId: ptr.To(id), JobState: &[]v0045.V0045JobInfoJobState{state}}
+	}
+	newReader := func() *jobStateReader {
+		return &jobStateReader{
+			states: []types.V0045JobState{
+				jobState(1, v0045.V0045JobInfoJobStateRUNNING),
+				jobState(2, v0045.V0045JobInfoJobStateRUNNING),
+				jobState(3, v0045.V0045JobInfoJobStatePENDING),
+				jobState(5, v0045.V0045JobInfoJobStateRUNNING),
+				jobState(6, v0045.V0045JobInfoJobStateRUNNING),
+			},
+			jobs: map[object.ObjectKey]*types.V0045JobInfo{
+				"1": job(1, v0045.V0045JobInfoJobStateRUNNING),
+				"2": job(2, v0045.V0045JobInfoJobStateRUNNING),
+				"3": job(3, v0045.V0045JobInfoJobStatePENDING),
+				"5": job(5, v0045.V0045JobInfoJobStateRUNNING),
+				"6": job(6, v0045.V0045JobInfoJobStateRUNNING),
+			},
+		}
+	}
+	now := time.Now()
+	tests := []struct {
+		name      string
+		cache     map[object.ObjectKey]*cacheEntry
+		wantGets  []object.ObjectKey
+		wantLists int
+	}{
+		{
+			name: "Fetch changed jobs",
+			cache: map[object.ObjectKey]*cacheEntry{
+				"1": {lastUpdate: now, object: job(1, v0045.V0045JobInfoJobStateRUNNING)},
+				"2": {lastUpdate: now, object: job(2, v0045.V0045JobInfoJobStatePENDING)},
+				"4": {lastUpdate: now, object: job(4, v0045.V0045JobInfoJobStateRUNNING)},
+				"5": {lastUpdate: now, object: job(5, v0045.V0045JobInfoJobStateRUNNING)},
+				"6": {lastUpdate: now, object: job(6, v0045.V0045JobInfoJobStateRUNNING)},
+			},
+			wantGets: []object.ObjectKey{"2", "3"},
+		},
+		{
+			name:      "List on empty cache",
+			cache:     map[object.ObjectKey]*cacheEntry{},
+			wantLists: 1,
+		},
+		{
+			name: "List when most jobs changed",
+			cache: map[object.ObjectKey]*cacheEntry{
+				"1": {lastUpdate: now, object: job(1, v0045.V0045JobInfoJobStateRUNNING)},
+				"2": {lastUpdate: now, object: job(2, v0045.V0045JobInfoJobStatePENDING)},
+				"5": {lastUpdate: now, dirty: true, object: job(5, v0045.V0045JobInfoJobStateRUNNING)},
+			},
+			wantLists: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader := newReader()
+			i := newInformerWithData(types.ObjectTypeV0045JobInfo, tt.cache)
+			i.reader = reader
+			i.jobStateSync = true
+
+			i.doListInformer()
+
+			if i.syncErrorList != nil {
+				t.Fatalf("doListInformer() syncErrorList = %v", i.syncErrorList)
+			}
+			if !reflect.DeepEqual(reader.gets, tt.wantGets) {
+				t.Errorf("doListInformer() fetched JobInfo for %v, want %v", reader.gets, tt.wantGets)
+			}
+			if reader.jobLists != tt.wantLists {
+				t.Errorf("doListInformer() listed JobInfo %v times, want %v", reader.jobLists, tt.wantLists)
+			}
+			if len(i.cache) != len(reader.jobs) {
+				t.Errorf("len(cache) = %v, want %v", len(i.cache), len(reader.jobs))
+			}
+			for key, want := range reader.jobs {
+				entry := i.cache[key]
+				if entry == nil || !reflect.DeepEqual(entry.object, want) {
+					t.Errorf("cache[%v] = %v, want %v", key, entry, want)
+				}
+			}
+		})
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type jobStateReader struct {
+	states   []types.V0045JobState
+	jobs     map[object.ObjectKey]*types.V0045JobInfo
+	gets     []object.ObjectKey
+	jobLists int
+}
+
+// Get implements Reader.
+func (r *jobStateReader) Get(ctx context.Context, key object.ObjectKey, obj object.Object, opts ...GetOption) error {
+	r.gets = append(r.gets, key)
+	job, ok := r.jobs[key]
+	if !ok {
+		return apierrors.ErrObjectNotFound
+	}
+	*obj.(*types.V0045JobInfo) = *job.DeepCopy()
+	return nil
+}
+
+// List implements Reader.
+func (r *jobStateReader) List(ctx context.Context, list object.ObjectList, opts ...ListOption) error {
+	switch l := list.(type) {
+	case *types.V0045JobStateList:
+		l.Items = r.states
+	case *types.V0045JobInfoList:
+		r.jobLists++
+		for _, state := range r.states {
+			l.Items = append(l.Items, *r.jobs[state.GetKey()].DeepCopy())
+		}
+	}
+	return nil
+}
+
+var _ Reader = &jobStateReader{}
+
 ////////////////////////////////////////////////////////////////////////////////
 
 type emptyClient struct{}
@@ -538,6 +672,36 @@ func (f *emptyClient) Update(ctx context.Context, obj object.Object, req any, op
 	return nil
 }
 
+// RequeueJob implements Client.
+func (f *emptyClient) RequeueJob(ctx context.Context, obj object.Object, opts ...RequeueOption) ([]JobResult, error) {
+	return nil, nil
+}
+
+// RequeueJobs implements Client.
+func (f *emptyClient) RequeueJobs(ctx context.Context, list object.ObjectList, opts ...RequeueOption) ([]JobResult, error) {
+	return nil, nil
+}
+
+// KillJobs implements Client.
+func (f *emptyClient) KillJobs(ctx context.Context, obj object.Object, opts ...KillJobsOption) ([]JobResult, error) {
+	return nil, nil
+}
+
+// UpdateNodes implements Client.
+func (f *emptyClient) UpdateNodes(ctx context.Context, list object.ObjectList, nodeNames []string, req any, opts ...UpdateOption) error {
+	return nil
+}
+
+// CreateOrUpdateReservations implements Client.
+func (f *emptyClient) CreateOrUpdateReservations(ctx context.Context, list object.ObjectList, req any, opts ...UpdateOption) ([]ReservationResult, error) {
+	return nil, nil
+}
+
+// EnsureUserInAccount implements Client.
+func (f *emptyClient) EnsureUserInAccount(ctx context.Context, obj object.Object, userName, account string, opts ...EnsureUserOption) error {
+	return nil
+}
+
 // Get implements Client.
 func (f *emptyClient) Get(ctx context.Context, key object.ObjectKey, obj object.Object, opts ...GetOption) error {
 	return nil
@@ -548,6 +712,21 @@ func (f *emptyClient) List(ctx context.Context, list object.ObjectList, opts ...
 	return nil
 }
 
+// DiscoverVersions implements Client.
+func (f *emptyClient) DiscoverVersions(ctx context.Context) error {
+	return nil
+}
+
+// SupportedVersions implements Client.
+func (f *emptyClient) SupportedVersions() []string {
+	return nil
+}
+
+// PreferredVersion implements Client.
+func (f *emptyClient) PreferredVersion(versions ...string) (string, error) {
+	return "", nil
+}
+
 // GetInformer implements Client.
 func (f *emptyClient) GetInformer(objectType object.ObjectType) InformerCache {
 	return nil
@@ -13,18 +13,27 @@ import (
 
 // Funcs contains functions that are called instead of the underlying client's methods.
 type Funcs struct {
-	Get              func(ctx context.Context, key object.ObjectKey, obj object.Object, opts ...client.GetOption) error
-	List             func(ctx context.Context, list object.ObjectList, opts ...client.ListOption) error
-	Create           func(ctx context.Context, obj object.Object, req any, opts ...client.CreateOption) error
-	Delete           func(ctx context.Context, obj object.Object, opts ...client.DeleteOption) error
-	Update           func(ctx context.Context, obj object.Object, req any, opts ...client.UpdateOption) error
-	GetInformer      func(obj object.ObjectType) client.InformerCache
-	GetServer        func() string
-	SetServer        func(server string)
-	GetToken         func() string
-	SetTokenProvider func(tokenProvider token.Provider)
-	Start            func(ctx context.Context)
-	Stop             func()
+	Get                        func(ctx context.Context, key object.ObjectKey, obj object.Object, opts ...client.GetOption) error
+	List                       func(ctx context.Context, list object.ObjectList, opts ...client.ListOption) error
+	Create                     func(ctx context.Context, obj object.Object, req any, opts ...client.CreateOption) error
+	Delete                     func(ctx context.Context, obj object.Object, opts ...client.DeleteOption) error
+	Update                     func(ctx context.Context, obj object.Object, req any, opts ...client.UpdateOption) error
+	RequeueJob                 func(ctx context.Context, obj object.Object, opts ...client.RequeueOption) ([]client.JobResult, error)
+	RequeueJobs                func(ctx context.Context, list object.ObjectList, opts ...client.RequeueOption) ([]client.JobResult, error)
+	KillJobs                   func(ctx context.Context, obj object.Object, opts ...client.KillJobsOption) ([]client.JobResult, error)
+	UpdateNodes                func(ctx context.Context, list object.ObjectList, nodeNames []string, req any, opts ...client.UpdateOption) error
+	CreateOrUpdateReservations func(ctx context.Context, list object.ObjectList, req any, opts ...client.UpdateOption) ([]client.ReservationResult, error)
+	EnsureUserInAccount        func(ctx context.Context, obj object.Object, userName, account string, opts ...client.EnsureUserOption) error
+	DiscoverVersions           func(ctx context.Context) error
+	SupportedVersions          func() []string
+	PreferredVersion           func(versions ...string) (string, error)
+	GetInformer                func(obj object.ObjectType) client.InformerCache
+	GetServer                  func() string
+	SetServer                  func(server string)
+	GetToken                   func() string
+	SetTokenProvider           func(tokenProvider token.Provider)
+	Start                      func(ctx context.Context)
+	Stop                       func()
 }
 
 // NewClient returns a new interceptor client that calls the functions in funcs instead of the underlying client's methods, if they are not nil.
@@ -75,6 +84,69 @@ func (c *interceptor) Update(ctx context.Context, obj object.Object, req any, op
 	return c.client.Update(ctx, obj, req, opts...)
 }
 
+func (c *interceptor) RequeueJob(ctx context.Context, obj object.Object, opts ...client.RequeueOption) ([]client.JobResult, error) {
+	if c.funcs.RequeueJob != nil {
+		return c.funcs.RequeueJob(ctx, obj, opts...)
+	}
+	return c.client.RequeueJob(ctx, obj, opts...)
+}
+
+func (c *interceptor) RequeueJobs(ctx context.Context, list object.ObjectList, opts ...client.RequeueOption) ([]client.JobResult, error) {
+	if c.funcs.RequeueJobs != nil {
+		return c.funcs.RequeueJobs(ctx, list, opts...)
+	}
+	return c.client.RequeueJobs(ctx, list, opts...)
+}
+
+func (c *interceptor) KillJobs(ctx context.Context, obj object.Object, opts ...client.KillJobsOption) ([]client.JobResult, error) {
+	if c.funcs.KillJobs != nil {
+		return c.funcs.KillJobs(ctx, obj, opts...)
+	}
+	return c.client.KillJobs(ctx, obj, opts...)
+}
+
+func (c *interceptor) UpdateNodes(ctx context.Context, list object.ObjectList, nodeNames []string, req any, opts ...client.UpdateOption) error {
+	if c.funcs.UpdateNodes != nil {
+		return c.funcs.UpdateNodes(ctx, list, nodeNames, req, opts...)
+	}
+	return c.client.UpdateNodes(ctx, list, nodeNames, req, opts...)
+}
+
+func (c *interceptor) CreateOrUpdateReservations(ctx context.Context, list object.ObjectList, req any, opts ...client.UpdateOption) ([]client.ReservationResult, error) {
+	if c.funcs.CreateOrUpdateReservations != nil {
+		return c.funcs.CreateOrUpdateReservations(ctx, list, req, opts...)
+	}
+	return c.client.CreateOrUpdateReservations(ctx, list, req, opts...)
+}
+
+func (c *interceptor) EnsureUserInAccount(ctx context.Context, obj object.Object, userName, account string, opts ...client.EnsureUserOption) error {
+	if c.funcs.EnsureUserInAccount != nil {
+		return c.funcs.EnsureUserInAccount(ctx, obj, userName, account, opts...)
+	}
+	return c.client.EnsureUserInAccount(ctx, obj, userName, account, opts...)
+}
+
+func (c *interceptor) DiscoverVersions(ctx context.Context) error {
+	if c.funcs.DiscoverVersions != nil {
+		return c.funcs.DiscoverVersions(ctx)
+	}
+	return c.client.DiscoverVersions(ctx)
+}
+
+func (c *interceptor) SupportedVersions() []string {
+	if c.funcs.SupportedVersions != nil {
+		return c.funcs.SupportedVersions()
+	}
+	return c.client.SupportedVersions()
+}
+
+func (c *interceptor) PreferredVersion(versions ...string) (string, error) {
+	if c.funcs.PreferredVersion != nil {
+		return c.funcs.PreferredVersion(versions...)
+	}
+	return c.client.PreferredVersion(versions...)
+}
+
 func (c *interceptor) GetInformer(objectType object.ObjectType) client.InformerCache {
 	if c.funcs.GetInformer != nil {
 		return c.funcs.GetInformer(objectType)
@@ -102,6 +102,170 @@ var _ = Describe("NewClient", func() {
 			Expect(called).To(BeTrue())
 		})
 	})
+	Context("UpdateNodes", func() {
+		It("should call the provided function", func() {
+			var called bool
+			client := NewClient(wrappedClient, Funcs{
+				UpdateNodes: func(ctx context.Context, list object.ObjectList, nodeNames []string, req any, opts ...client.UpdateOption) error {
+					called = true
+					return nil
+				},
+			})
+			list := &types.V0042NodeList{}
+			req := v0042.V0042UpdateNodeMsg{}
+			_ = client.UpdateNodes(ctx, list, []string{"node-0"}, req)
+			Expect(called).To(BeTrue())
+		})
+		It("should call the underlying client if the provided function is nil", func() {
+			var called bool
+			client1 := NewClient(wrappedClient, Funcs{
+				UpdateNodes: func(ctx context.Context, list object.ObjectList, nodeNames []string, req any, opts ...client.UpdateOption) error {
+					called = true
+					return nil
+				},
+			})
+			list := &types.V0042NodeList{}
+			req := v0042.V0042UpdateNodeMsg{}
+			client2 := NewClient(client1, Funcs{})
+			_ = client2.UpdateNodes(ctx, list, []string{"node-0"}, req)
+			Expect(called).To(BeTrue())
+		})
+	})
+	Context("CreateOrUpdateReservations", func() {
+		It("should call the provided function", func() {
+			var called bool
+			client := NewClient(wrappedClient, Funcs{
+				CreateOrUpdateReservations: func(ctx context.Context, list object.ObjectList, req any, opts ...client.UpdateOption) ([]client.ReservationResult, error) {
+					called = true
+					return nil, nil
+				},
+			})
+			list := &types.V0045ReservationInfoList{}
+			_, _ = client.CreateOrUpdateReservations(ctx, list, nil)
+			Expect(called).To(BeTrue())
+		})
+		It("should call the underlying client if the provided function is nil", func() {
+			var called bool
+			client1 := NewClient(wrappedClient, Funcs{
+				CreateOrUpdateReservations: func(ctx context.Context, list object.ObjectList, req any, opts ...client.UpdateOption) ([]client.ReservationResult, error) {
+					called = true
+					return nil, nil
+				},
+			})
+			list := &types.V0045ReservationInfoList{}
+			client2 := NewClient(client1, Funcs{})
+			_, _ = client2.CreateOrUpdateReservations(ctx, list, nil)
+			Expect(called).To(BeTrue())
+		})
+	})
+	Context("EnsureUserInAccount", func() {
+		It("should call the provided function", func() {
+			var called bool
+			client := NewClient(wrappedClient, Funcs{
+				EnsureUserInAccount: func(ctx context.Context, obj object.Object, userName, account string, opts ...client.EnsureUserOption) error {
+					called = true
+					return nil
+				},
+			})
+			obj := &types.V0045User{}
+			_ = client.EnsureUserInAccount(ctx, obj, "user-0", "account-0")
+			Expect(called).To(BeTrue())
+		})
+		It("should call the underlying client if the provided function is nil", func() {
+			var called bool
+			client1 := NewClient(wrappedClient, Funcs{
+				EnsureUserInAccount: func(ctx context.Context, obj object.Object, userName, account string, opts ...client.EnsureUserOption) error {
+					called = true
+					return nil
+				},
+			})
+			obj := &types.V0045User{}
+			client2 := NewClient(client1, Funcs{})
+			_ = client2.EnsureUserInAccount(ctx, obj, "user-0", "account-0")
+			Expect(called).To(BeTrue())
+		})
+	})
+	Context("RequeueJob", func() {
+		It("should call the provided function", func() {
+			var called bool
+			client := NewClient(wrappedClient, Funcs{
+				RequeueJob: func(ctx context.Context, obj object.Object, opts ...client.RequeueOption) ([]client.JobResult, error) {
+					called = true
+					return nil, nil
+				},
+			})
+			obj := &types.V0045JobInfo{}
+			_, _ = client.RequeueJob(ctx, obj)
+			Expect(called).To(BeTrue())
+		})
+		It("should call the underlying client if the provided function is nil", func() {
+			var called bool
+			client1 := NewClient(wrappedClient, Funcs{
+				RequeueJob: func(ctx context.Context, obj object.Object, opts ...client.RequeueOption) ([]client.JobResult, error) {
+					called = true
+					return nil, nil
+				},
+			})
+			obj := &types.V0045JobInfo{}
+			client2 := NewClient(client1, Funcs{})
+			_, _ = client2.RequeueJob(ctx, obj)
+			Expect(called).To(BeTrue())
+		})
+	})
+	Context("RequeueJobs", func() {
+		It("should call the provided function", func() {
+			var called bool
+			client := NewClient(wrappedClient, Funcs{
+				RequeueJobs: func(ctx context.Context, list object.ObjectList, opts ...client.RequeueOption) ([]client.JobResult, error) {
+					called = true
+					return nil, nil
+				},
+			})
+			list := &types.V0045JobInfoList{}
+			_, _ = client.RequeueJobs(ctx, list)
+			Expect(called).To(BeTrue())
+		})
+		It("should call the underlying client if the provided function is nil", func() {
+			var called bool
+			client1 := NewClient(wrappedClient, Funcs{
+				RequeueJobs: func(ctx context.Context, list object.ObjectList, opts ...client.RequeueOption) ([]client.JobResult, error) {
+					called = true
+					return nil, nil
+				},
+			})
+			list := &types.V0045JobInfoList{}
+			client2 := NewClient(client1, Funcs{})
+			_, _ = client2.RequeueJobs(ctx, list)
+			Expect(called).To(BeTrue())
+		})
+	})
+	Context("KillJobs", func() {
+		It("should call the provided function", func() {
+			var called bool
+			client := NewClient(wrappedClient, Funcs{
+				KillJobs: func(ctx context.Context, obj object.Object, opts ...client.KillJobsOption) ([]client.JobResult, error) {
+					called = true
+					return nil, nil
+				},
+			})
+			obj := &types.V0045JobInfo{}
+			_, _ = client.KillJobs(ctx, obj)
+			Expect(called).To(BeTrue())
+		})
+		It("should call the underlying client if the provided function is nil", func() {
+			var called bool
+			client1 := NewClient(wrappedClient, Funcs{
+				KillJobs: func(ctx context.Context, obj object.Object, opts ...client.KillJobsOption) ([]client.JobResult, error) {
+					called = true
+					return nil, nil
+				},
+			})
+			obj := &types.V0045JobInfo{}
+			client2 := NewClient(client1, Funcs{})
+			_, _ = client2.KillJobs(ctx, obj)
+			Expect(called).To(BeTrue())
+		})
+	})
 	Context("Get", func() {
 		It("should call the provided function", func() {
 			var called bool
@@ -183,6 +347,81 @@ var _ = Describe("NewClient", func() {
 			Expect(called).To(BeTrue())
 		})
 	})
+	Context("DiscoverVersions", func() {
+		It("should call the provided function", func() {
+			var called bool
+			client := NewClient(wrappedClient, Funcs{
+				DiscoverVersions: func(ctx context.Context) error {
+					called = true
+					return nil
+				},
+			})
+			_ = client.DiscoverVersions(ctx)
+			Expect(called).To(BeTrue())
+		})
+		It("should call the underlying client if the provided function is nil", func() {
+			var called bool
+			client1 := NewClient(wrappedClient, Funcs{
+				DiscoverVersions: func(ctx context.Context) error {
+					called = true
+					return nil
+				},
+			})
+			client2 := NewClient(client1, Funcs{})
+			_ = client2.DiscoverVersions(ctx)
+			Expect(called).To(BeTrue())
+		})
+	})
+	Context("SupportedVersions", func() {
+		It("should call the provided function", func() {
+			var called bool
+			client := NewClient(wrappedClient, Funcs{
+				SupportedVersions: func() []string {
+					called = true
+					return nil
+				},
+			})
+			_ = client.SupportedVersions()
+			Expect(called).To(BeTrue())
+		})
+		It("should call the underlying client if the provided function is nil", func() {
+			var called bool
+			client1 := NewClient(wrappedClient, Funcs{
+				SupportedVersions: func() []string {
+					called = true
+					return nil
+				},
+			})
+			client2 := NewClient(client1, Funcs{})
+			_ = client2.SupportedVersions()
+			Expect(called).To(BeTrue())
+		})
+	})
+	Context("PreferredVersion", func() {
+		It("should call the provided function", func() {
+			var called bool
+			client := NewClient(wrappedClient, Funcs{
+				PreferredVersion: func(versions ...string) (string, error) {
+					called = true
+					return "", nil
+				},
+			})
+			_, _ = client.PreferredVersion()
+			Expect(called).To(BeTrue())
+		})
+		It("should call the underlying client if the provided function is nil", func() {
+			var called bool
+			client1 := NewClient(wrappedClient, Funcs{
+				PreferredVersion: func(versions ...string) (string, error) {
+					called = true
+					return "", nil
+				},
+			})
+			client2 := NewClient(client1, Funcs{})
+			_, _ = client2.PreferredVersion()
+			Expect(called).To(BeTrue())
+		})
+	})
 	Context("SetServer", func() {
 		It("should call the provided function", func() {
 			var called bool
@@ -296,6 +535,36 @@ func (e *emptyClient) Update(ctx context.Context, obj object.Object, req any, op
 	return nil
 }
 
+// RequeueJob implements client.Client.
+func (e *emptyClient) RequeueJob(ctx context.Context, obj object.Object, opts ...client.RequeueOption) ([]client.JobResult, error) {
+	return nil, nil
+}
+
+// RequeueJobs implements client.Client.
+func (e *emptyClient) RequeueJobs(ctx context.Context, list object.ObjectList, opts ...client.RequeueOption) ([]client.JobResult, error) {
+	return nil, nil
+}
+
+// KillJobs implements client.Client.
+func (e *emptyClient) KillJobs(ctx context.Context, obj object.Object, opts ...client.KillJobsOption) ([]client.JobResult, error) {
+	return nil, nil
+}
+
+// UpdateNodes implements client.Client.
+func (e *emptyClient) UpdateNodes(ctx context.Context, list object.ObjectList, nodeNames []string, req any, opts ...client.UpdateOption) error {
+	return nil
+}
+
+// CreateOrUpdateReservations implements client.Client.
+func (e *emptyClient) CreateOrUpdateReservations(ctx context.Context, list object.ObjectList, req any, opts ...client.UpdateOption) ([]client.ReservationResult, error) {
+	return nil, nil
+}
+
+// EnsureUserInAccount implements client.Client.
+func (e *emptyClient) EnsureUserInAccount(ctx context.Context, obj object.Object, userName, account string, opts ...client.EnsureUserOption) error {
+	return nil
+}
+
 // Get implements client.Client.
 func (e *emptyClient) Get(ctx context.Context, key object.ObjectKey, obj object.Object, opts ...client.GetOption) error {
 	return nil
@@ -306,6 +575,21 @@ func (e *emptyClient) List(ctx context.Context, list object.ObjectList, opts ...
 	return nil
 }
 
+// DiscoverVersions implements client.Client.
+func (e *emptyClient) DiscoverVersions(ctx context.Context) error {
+	return nil
+}
+
+// SupportedVersions implements client.Client.
+func (e *emptyClient) SupportedVersions() []string {
+	return nil
+}
+
+// PreferredVersion implements client.Client.
+func (e *emptyClient) PreferredVersion(versions ...string) (string, error) {
+	return "", nil
+}
+
 // GetInformer implements client.Client.
 func (e *emptyClient) GetInformer(objectType object.ObjectType) client.InformerCache {
 	return nil
@@ -8,6 +8,7 @@ import (
 	"context"
 
 	"github.com/SlinkyProject/slurm-client/pkg/cache"
+	clientapi "github.com/SlinkyProject/slurm-client/pkg/client/api"
 	"github.com/SlinkyProject/slurm-client/pkg/client/token"
 	"github.com/SlinkyProject/slurm-client/pkg/object"
 )
@@ -47,10 +48,80 @@ type Writer interface {
 	Delete(ctx context.Context, obj object.Object, opts ...DeleteOption) error
 }
 
+// JobResult is the result of a job operation on a single job.
+type JobResult = clientapi.JobResult
+
+// JobWriter knows how to perform job control operations on Slurm jobs.
+type JobWriter interface {
+	// RequeueJob requeues the given job obj in the Slurm cluster. obj must be a
+	// struct pointer so that obj can be updated with the content returned by the Server.
+	RequeueJob(ctx context.Context, obj object.Object, opts ...RequeueOption) ([]JobResult, error)
+
+	// RequeueJobs requeues all jobs in the given list in the Slurm cluster.
+	// Items in the list are updated with the content returned by the Server.
+	RequeueJobs(ctx context.Context, list object.ObjectList, opts ...RequeueOption) ([]JobResult, error)
+
+	// KillJobs signals all jobs of the given obj type which match the
+	// filters in opts, in one request. The default signal cancels the jobs.
+	KillJobs(ctx context.Context, obj object.Object, opts ...KillJobsOption) ([]JobResult, error)
+}
+
+// NodeWriter knows how to perform bulk operations on Slurm nodes.
+type NodeWriter interface {
+	// UpdateNodes updates all nodes in nodeNames, each a node name or hostlist
+	// expression (e.g. "node[0-9]"), in one request. On success, list is
+	// populated with the updated nodes returned by the Server.
+	UpdateNodes(ctx context.Context, list object.ObjectList, nodeNames []string, req any, opts ...UpdateOption) error
+}
+
+// ReservationResult is the result of a reservation operation on a single reservation.
+type ReservationResult = clientapi.ReservationResult
+
+// ReservationWriter knows how to perform batch operations on Slurm reservations.
+type ReservationWriter interface {
+	// CreateOrUpdateReservations creates or updates all reservations in req
+	// together, in one request. The list is populated with the reservations
+	// returned by the Server.
+	CreateOrUpdateReservations(ctx context.Context, list object.ObjectList, req any, opts ...UpdateOption) ([]ReservationResult, error)
+}
+
+// UserWriter knows how to manage the accounts of slurmdbd users.
+type UserWriter interface {
+	// EnsureUserInAccount makes sure userName exists and is associated with
+	// account, creating the user and association together in one request when
+	// needed. obj must be a struct pointer so that obj can be updated with the
+	// user returned by the Server.
+	EnsureUserInAccount(ctx context.Context, obj object.Object, userName, account string, opts ...EnsureUserOption) error
+}
+
+// Versions knows which Slurm REST API versions the server serves.
+type Versions interface {
+	// DiscoverVersions probes the OpenAPI specification of the server for the
+	// versions it serves. Afterwards, requests for objects of other versions
+	// fail with an UnsupportedVersionError. The versions of the slurm and
+	// slurmdb paths are discovered separately, slurmdbd objects being checked
+	// against the latter. Until then, or after the server is changed, all
+	// versions known to the client are assumed to be served.
+	DiscoverVersions(ctx context.Context) error
+
+	// SupportedVersions returns the versions known to the client and served by
+	// the server, oldest first.
+	SupportedVersions() []string
+
+	// PreferredVersion returns the newest supported version among versions, or
+	// among all supported versions when none are given.
+	PreferredVersion(versions ...string) (string, error)
+}
+
 // Client knows how to perform CRUD operations on Slurm objects.
 type Client interface {
 	Reader
 	Writer
+	JobWriter
+	NodeWriter
+	ReservationWriter
+	UserWriter
+	Versions
 	Informers
 
 	SetServer(server string)
@@ -7,6 +7,7 @@ package client
 import (
 	"time"
 
+	clientapi "github.com/SlinkyProject/slurm-client/pkg/client/api"
 	"github.com/SlinkyProject/slurm-client/pkg/object"
 )
 
@@ -34,6 +35,18 @@ type DeleteOption interface {
 	ApplyToDelete(*DeleteOptions)
 }
 
+// KillJobsOption is some configuration that modifies options for a kill jobs request.
+type KillJobsOption interface {
+	// ApplyToKillJobs applies this configuration to the given kill jobs options.
+	ApplyToKillJobs(*KillJobsOptions)
+}
+
+// EnsureUserOption is some configuration that modifies options for an ensure user request.
+type EnsureUserOption interface {
+	// ApplyToEnsureUser applies this configuration to the given ensure user options.
+	ApplyToEnsureUser(*EnsureUserOptions)
+}
+
 // GetOption is some configuration that modifies options for a get request.
 type GetOption interface {
 	// ApplyToGet applies this configuration to the given get options.
@@ -46,6 +59,12 @@ type ListOption interface {
 	ApplyToList(*ListOptions)
 }
 
+// RequeueOption is some configuration that modifies options for a requeue request.
+type RequeueOption interface {
+	// ApplyToRequeue applies this configuration to the given requeue options.
+	ApplyToRequeue(*RequeueOptions)
+}
+
 // UpdateOption is some configuration that modifies options for a update request.
 type UpdateOption interface {
 	// ApplyToUpdate applies this configuration to the given update options.
@@ -66,6 +85,19 @@ type ClientOptions struct {
 
 	// CacheSyncPeriod is the time to wait before updating the cache
 	CacheSyncPeriod time.Duration
+
+	// JobStateSync indicates the V0045JobInfo informer to sync through the
+	// lightweight V0045JobState list, only fetching the full JobInfo of jobs
+	// which are new or whose state has changed. Changes to other fields of a
+	// job are not observed until its state changes or it is refreshed.
+	JobStateSync bool
+
+	// DiscoverVersions indicates the client to probe the server for the
+	// versions it serves on creation and on SetServer, failing requests for
+	// other versions. When the server set by SetServer cannot be probed,
+	// versioned requests fail with the discovery error until
+	// Client.DiscoverVersions succeeds. See Client.DiscoverVersions.
+	DiscoverVersions bool
 }
 
 // ApplyOptions applies the given create options on these options,
@@ -85,6 +117,8 @@ func (o *ClientOptions) ApplyToClient(co *ClientOptions) {
 		o.CacheSyncPeriod = defaultSyncPeriod
 	}
 	co.CacheSyncPeriod = o.CacheSyncPeriod
+	co.JobStateSync = o.JobStateSync
+	co.DiscoverVersions = o.DiscoverVersions
 }
 
 var _ ClientOption = &ClientOptions{}
@@ -96,6 +130,12 @@ var _ ClientOption = &ClientOptions{}
 // CreateOptions contains options for create requests. It's generally a subset
 // of metav1.CreateOptions.
 type CreateOptions struct {
+	// Allocate indicates to allocate resources for a job without a batch
+	// script, instead of submitting one. Only v0045 JobInfo supports it, with a
+	// V0045JobAllocReq or V0045JobDescMsg request. Slurm does not report the
+	// allocated nodes on allocation, so the object read back has none while
+	// the job is pending. See V0045JobInfo.GetAllocatedNodes.
+	Allocate bool
 }
 
 // ApplyOptions applies the given create options on these options,
@@ -109,6 +149,7 @@ func (o *CreateOptions) ApplyOptions(opts []CreateOption) *CreateOptions {
 
 // ApplyToCreate implements CreateOption.
 func (o *CreateOptions) ApplyToCreate(co *CreateOptions) {
+	co.Allocate = o.Allocate
 }
 
 var _ CreateOption = &CreateOptions{}
@@ -139,6 +180,127 @@ func (o *DeleteOptions) ApplyToDelete(do *DeleteOptions) {
 
 // }}}
 
+// {{{ KillJobs Options
+
+// KillJobsOptions contains options for kill jobs requests. At least one
+// filter must be set.
+type KillJobsOptions struct {
+	// Signal is the signal to send to the jobs (e.g. "SIGTERM", "15").
+	// The default is SIGKILL, which cancels the jobs.
+	Signal string
+
+	// JobIds filters to the given job IDs.
+	JobIds []string
+
+	// Account filters to jobs of the given account.
+	Account string
+
+	// UserName filters to jobs of the given user name.
+	UserName string
+
+	// Partition filters to jobs in the given partition.
+	Partition string
+
+	// Qos filters to jobs with the given QOS.
+	Qos string
+
+	// JobState filters to jobs in any of the given states (e.g. "PENDING").
+	JobState []string
+
+	// Reservation filters to jobs in the given reservation.
+	Reservation string
+
+	// JobName filters to jobs with the given name.
+	JobName string
+
+	// Full indicates to signal every step of the jobs, including the batch
+	// and extern steps (scancel --full).
+	Full bool
+
+	// Batch indicates to only signal the batch step of the jobs
+	// (scancel --batch).
+	Batch bool
+
+	// Hurry indicates to skip the burst buffer stage out (scancel --hurry).
+	Hurry bool
+}
+
+// ApplyOptions applies the given kill jobs options on these options,
+// and then returns itself (for convenient chaining).
+func (o *KillJobsOptions) ApplyOptions(opts []KillJobsOption) *KillJobsOptions {
+	for _, opt := range opts {
+		opt.ApplyToKillJobs(o)
+	}
+	return o
+}
+
+var _ KillJobsOption = &KillJobsOptions{}
+
+// ApplyToKillJobs implements KillJobsOption.
+func (o *KillJobsOptions) ApplyToKillJobs(do *KillJobsOptions) {
+	do.Signal = o.Signal
+	do.JobIds = o.JobIds
+	do.Account = o.Account
+	do.UserName = o.UserName
+	do.Partition = o.Partition
+	do.Qos = o.Qos
+	do.JobState = o.JobState
+	do.Reservation = o.Reservation
+	do.JobName = o.JobName
+	do.Full = o.Full
+	do.Batch = o.Batch
+	do.Hurry = o.Hurry
+}
+
+// HasFilter returns true if any job filter is set.
+func (o *KillJobsOptions) HasFilter() bool {
+	return len(o.JobIds) > 0 ||
+		o.Account != "" ||
+		o.UserName != "" ||
+		o.Partition != "" ||
+		o.Qos != "" ||
+		len(o.JobState) > 0 ||
+		o.Reservation != "" ||
+		o.JobName != ""
+}
+
+// }}}
+
+// {{{ EnsureUser Options
+
+// EnsureUserOptions contains options for ensure user requests.
+type EnsureUserOptions struct {
+	// Clusters limits the association to the given clusters.
+	// The default is the cluster of the slurmdbd.
+	Clusters []string
+
+	// Partitions limits the association to the given partitions.
+	Partitions []string
+
+	// DefaultAccount indicates to make the account the default account of the user.
+	DefaultAccount bool
+}
+
+// ApplyOptions applies the given ensure user options on these options,
+// and then returns itself (for convenient chaining).
+func (o *EnsureUserOptions) ApplyOptions(opts []EnsureUserOption) *EnsureUserOptions {
+	for _, opt := range opts {
+		opt.ApplyToEnsureUser(o)
+	}
+	return o
+}
+
+var _ EnsureUserOption = &EnsureUserOptions{}
+
+// ApplyToEnsureUser implements EnsureUserOption.
+func (o *EnsureUserOptions) ApplyToEnsureUser(eo *EnsureUserOptions) {
+	eo.Clusters = o.Clusters
+	eo.Partitions = o.Partitions
+	eo.DefaultAccount = o.DefaultAccount
+}
+
+// }}}
+
 // {{{ Get Options
 
 // GetOptions contains options for get operation.
@@ -152,6 +314,11 @@ type GetOptions struct {
 
 	// WaitRefreshCache indicates to wait for the next cache refresh before reading from it.
 	WaitRefreshCache bool
+
+	// Params are the version specific query parameters of the get request
+	// (e.g. v0045.SlurmdbV0045GetAccountParams).
+	// Setting Params implies SkipCache.
+	Params any
 }
 
 var _ GetOption = &GetOptions{}
@@ -161,6 +328,7 @@ func (o *GetOptions) ApplyToGet(lo *GetOptions) {
 	lo.SkipCache = o.SkipCache
 	lo.RefreshCache = o.RefreshCache
 	lo.WaitRefreshCache = o.WaitRefreshCache
+	lo.Params = o.Params
 }
 
 // ApplyOptions applies the given get options on these options,
@@ -189,6 +357,11 @@ type ListOptions struct {
 
 	// WaitRefreshCache indicates to wait for the next cache refresh before reading from it.
 	WaitRefreshCache bool
+
+	// Params are the version specific query parameters of the list request
+	// (e.g. v0045.SlurmV0045GetSharesParams), used to filter server-side.
+	// Setting Params implies SkipCache.
+	Params any
 }
 
 var _ ListOption = &ListOptions{}
@@ -198,6 +371,7 @@ func (o *ListOptions) ApplyToList(lo *ListOptions) {
 	lo.SkipCache = o.SkipCache
 	lo.RefreshCache = o.RefreshCache
 	lo.WaitRefreshCache = o.WaitRefreshCache
+	lo.Params = o.Params
 }
 
 // ApplyOptions applies the given list options on these options,
@@ -211,6 +385,49 @@ func (o *ListOptions) ApplyOptions(opts []ListOption) *ListOptions {
 
 // }}}
 
+// {{{ Requeue Options
+
+// RequeueOptions contains options for requeue requests.
+type RequeueOptions struct {
+	// Hold indicates to hold the job after requeue.
+	Hold bool
+
+	// SpecialExit indicates to set the SPECIAL_EXIT state after requeue.
+	// Requires Hold.
+	SpecialExit bool
+
+	// Incomplete indicates to only requeue jobs which have not completed.
+	Incomplete bool
+}
+
+// ApplyOptions applies the given requeue options on these options,
+// and then returns itself (for convenient chaining).
+func (o *RequeueOptions) ApplyOptions(opts []RequeueOption) *RequeueOptions {
+	for _, opt := range opts {
+		opt.ApplyToRequeue(o)
+	}
+	return o
+}
+
+var _ RequeueOption = &RequeueOptions{}
+
+// ApplyToRequeue implements RequeueOption.
+func (o *RequeueOptions) ApplyToRequeue(ro *RequeueOptions) {
+	ro.Hold = o.Hold
+	ro.SpecialExit = o.SpecialExit
+	ro.Incomplete = o.Incomplete
+}
+
+func (o *RequeueOptions) toFlags() clientapi.RequeueFlags {
+	return clientapi.RequeueFlags{
+		Hold:        o.Hold,
+		SpecialExit: o.SpecialExit,
+		Incomplete:  o.Incomplete,
+	}
+}
+
+// }}}
+
 // {{{ Update Options
 
 // UpdateOptions contains options for create requests.
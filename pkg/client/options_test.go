@@ -47,6 +47,7 @@ func TestClientOptions_ApplyOptions(t *testing.T) {
 							&types.V0042Node{},
 						},
 						CacheSyncPeriod: 2 * time.Second,
+						JobStateSync:    true,
 					},
 				},
 			},
@@ -58,6 +59,7 @@ func TestClientOptions_ApplyOptions(t *testing.T) {
 					&types.V0042Node{},
 				},
 				CacheSyncPeriod: 2 * time.Second,
+				JobStateSync:    true,
 			},
 		},
 		{
@@ -111,7 +113,7 @@ func TestClientOptions_ApplyOptions(t *testing.T) {
 
 func TestCreateOptions_ApplyOptions(t *testing.T) {
 	type fields struct {
-		Allocation bool
+		Allocate bool
 	}
 	type args struct {
 		opts []CreateOption
@@ -128,6 +130,20 @@ func TestCreateOptions_ApplyOptions(t *testing.T) {
 			args:   args{},
 			want:   &CreateOptions{},
 		},
+		{
+			name:   "From options",
+			fields: fields{},
+			args: args{
+				opts: []CreateOption{
+					&CreateOptions{
+						Allocate: true,
+					},
+				},
+			},
+			want: &CreateOptions{
+				Allocate: true,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -186,6 +202,139 @@ func TestDeleteOptions_ApplyOptions(t *testing.T) {
 	}
 }
 
+func TestKillJobsOptions_ApplyOptions(t *testing.T) {
+	type args struct {
+		opts []KillJobsOption
+	}
+	tests := []struct {
+		name string
+		args args
+		want *KillJobsOptions
+	}{
+		{
+			name: "No options",
+			args: args{},
+			want: &KillJobsOptions{},
+		},
+		{
+			name: "From options",
+			args: args{
+				opts: []KillJobsOption{
+					&KillJobsOptions{
+						Signal:      "SIGTERM",
+						JobIds:      []string{"1", "2"},
+						Account:     "account",
+						UserName:    "user",
+						Partition:   "partition",
+						Qos:         "qos",
+						JobState:    []string{"PENDING"},
+						Reservation: "reservation",
+						JobName:     "name",
+						Full:        true,
+						Hurry:       true,
+					},
+				},
+			},
+			want: &KillJobsOptions{
+				Signal:      "SIGTERM",
+				JobIds:      []string{"1", "2"},
+				Account:     "account",
+				UserName:    "user",
+				Partition:   "partition",
+				Qos:         "qos",
+				JobState:    []string{"PENDING"},
+				Reservation: "reservation",
+				JobName:     "name",
+				Full:        true,
+				Hurry:       true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &KillJobsOptions{}
+			got := o.ApplyOptions(tt.args.opts)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestKillJobsOptions_HasFilter(t *testing.T) {
+	tests := []struct {
+		name string
+		opts *KillJobsOptions
+		want bool
+	}{
+		{
+			name: "Empty",
+			opts: &KillJobsOptions{},
+			want: false,
+		},
+		{
+			name: "Signal only",
+			opts: &KillJobsOptions{Signal: "SIGTERM"},
+			want: false,
+		},
+		{
+			name: "Account",
+			opts: &KillJobsOptions{Account: "account"},
+			want: true,
+		},
+		{
+			name: "JobState",
+			opts: &KillJobsOptions{JobState: []string{"PENDING"}},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.opts.HasFilter()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEnsureUserOptions_ApplyOptions(t *testing.T) {
+	type args struct {
+		opts []EnsureUserOption
+	}
+	tests := []struct {
+		name string
+		args args
+		want *EnsureUserOptions
+	}{
+		{
+			name: "No options",
+			args: args{},
+			want: &EnsureUserOptions{},
+		},
+		{
+			name: "From options",
+			args: args{
+				opts: []EnsureUserOption{
+					&EnsureUserOptions{
+						Clusters:       []string{"linux"},
+						Partitions:     []string{"debug"},
+						DefaultAccount: true,
+					},
+				},
+			},
+			want: &EnsureUserOptions{
+				Clusters:       []string{"linux"},
+				Partitions:     []string{"debug"},
+				DefaultAccount: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &EnsureUserOptions{}
+			got := o.ApplyOptions(tt.args.opts)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestGetOptions_ApplyOptions(t *testing.T) {
 	type fields struct {
 		SkipCache    bool
@@ -241,6 +390,20 @@ func TestGetOptions_ApplyOptions(t *testing.T) {
 				RefreshCache: false,
 			},
 		},
+		{
+			name:   "With params",
+			fields: fields{},
+			args: args{
+				opts: []GetOption{
+					&GetOptions{
+						Params: "params",
+					},
+				},
+			},
+			want: &GetOptions{
+				Params: "params",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -290,6 +453,20 @@ func TestListOptions_ApplyOptions(t *testing.T) {
 				RefreshCache: true,
 			},
 		},
+		{
+			name:   "With params",
+			fields: fields{},
+			args: args{
+				opts: []ListOption{
+					&ListOptions{
+						Params: "params",
+					},
+				},
+			},
+			want: &ListOptions{
+				Params: "params",
+			},
+		},
 		{
 			name: "Overwrite existing options",
 			fields: fields{
@@ -322,6 +499,55 @@ func TestListOptions_ApplyOptions(t *testing.T) {
 	}
 }
 
+func TestRequeueOptions_ApplyOptions(t *testing.T) {
+	type fields struct {
+		Hold        bool
+		SpecialExit bool
+		Incomplete  bool
+	}
+	type args struct {
+		opts []RequeueOption
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   *RequeueOptions
+	}{
+		{
+			name:   "No options",
+			fields: fields{},
+			args:   args{},
+			want:   &RequeueOptions{},
+		},
+		{
+			name:   "From options",
+			fields: fields{},
+			args: args{
+				opts: []RequeueOption{
+					&RequeueOptions{
+						Hold:        true,
+						SpecialExit: true,
+						Incomplete:  true,
+					},
+				},
+			},
+			want: &RequeueOptions{
+				Hold:        true,
+				SpecialExit: true,
+				Incomplete:  true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &RequeueOptions{}
+			got := o.ApplyOptions(tt.args.opts)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestUpdateOptions_ApplyOptions(t *testing.T) {
 	type fields struct {
 	}
@@ -0,0 +1,276 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"k8s.io/utils/set"
+
+	apierrors "github.com/SlinkyProject/slurm-client/pkg/errors"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+	"github.com/SlinkyProject/slurm-client/pkg/version"
+)
+
+// Slurm REST API data parser versions implemented by this client.
+const (
+	VersionV0042 = version.V0042
+	VersionV0043 = version.V0043
+	VersionV0044 = version.V0044
+	VersionV0045 = version.V0045
+)
+
+// Slurm REST API plugins, as the first element of their paths.
+const (
+	pluginSlurm   = "slurm"
+	pluginSlurmdb = "slurmdb"
+)
+
+const (
+	// openapiSpecPath serves the OpenAPI specification of every plugin loaded
+	// by slurmrestd.
+	openapiSpecPath = "/openapi/v3"
+
+	headerSlurmUserToken = "X-SLURM-USER-TOKEN" //nolint:gosec // disable G101
+)
+
+var (
+	// versionPathRegex matches the plugin and version of a slurm or slurmdb
+	// plugin path.
+	versionPathRegex = regexp.MustCompile(`^/(slurm|slurmdb)/(v\d+\.\d+\.\d+)/`)
+
+	// objectTypeVersions maps the prefix of object types to their version.
+	objectTypeVersions = map[string]string{
+		"V0042": VersionV0042,
+		"V0043": VersionV0043,
+		"V0044": VersionV0044,
+		"V0045": VersionV0045,
+	}
+
+	// slurmdbObjectTypes are the object types served by the slurmdb plugin,
+	// all others are served by the slurm plugin.
+	slurmdbObjectTypes = set.New[object.ObjectType](
+		types.ObjectTypeV0045Account,
+		types.ObjectTypeV0045AccountingJob,
+		types.ObjectTypeV0045Association,
+		types.ObjectTypeV0045Cluster,
+		types.ObjectTypeV0045DbdPing,
+		types.ObjectTypeV0045DbdStats,
+		types.ObjectTypeV0045Instance,
+		types.ObjectTypeV0045Qos,
+		types.ObjectTypeV0045Tres,
+		types.ObjectTypeV0045User,
+		types.ObjectTypeV0045WCKey,
+	)
+)
+
+// KnownVersions returns the versions implemented by this client, oldest first.
+func KnownVersions() []string {
+	return version.Known()
+}
+
+// ObjectTypeVersion returns the version of objectType (e.g. "v0.0.45" for
+// "V0045Node"), or an empty string if it is not versioned.
+func ObjectTypeVersion(objectType object.ObjectType) string {
+	if len(objectType) < 5 {
+		return ""
+	}
+	return objectTypeVersions[string(objectType[:5])]
+}
+
+// DiscoverVersions implements Client.
+func (c *client) DiscoverVersions(ctx context.Context) error {
+	served, err := c.fetchVersions(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to discover versions: %w", err)
+	}
+
+	supported := map[string][]string{}
+	for _, plugin := range []string{pluginSlurm, pluginSlurmdb} {
+		supported[plugin] = []string{}
+		for _, version := range KnownVersions() {
+			if slices.Contains(served[plugin], version) {
+				supported[plugin] = append(supported[plugin], version)
+			}
+		}
+	}
+
+	c.versionsMu.Lock()
+	defer c.versionsMu.Unlock()
+	c.supportedVersions = supported
+	c.versionsErr = nil
+	return nil
+}
+
+// rediscoverVersions discovers the versions of a new server. If it cannot be
+// probed, the error is recorded and requests fail with it until
+// DiscoverVersions succeeds.
+func (c *client) rediscoverVersions() {
+	err := c.DiscoverVersions(c.ctx)
+
+	c.versionsMu.Lock()
+	defer c.versionsMu.Unlock()
+	c.versionsErr = err
+}
+
+// SupportedVersions implements Client.
+func (c *client) SupportedVersions() []string {
+	c.versionsMu.RLock()
+	defer c.versionsMu.RUnlock()
+
+	if c.supportedVersions == nil {
+		return KnownVersions()
+	}
+	supported := []string{}
+	for _, version := range KnownVersions() {
+		if slices.Contains(c.supportedVersions[pluginSlurm], version) ||
+			slices.Contains(c.supportedVersions[pluginSlurmdb], version) {
+			supported = append(supported, version)
+		}
+	}
+	return supported
+}
+
+// PreferredVersion implements Client.
+func (c *client) PreferredVersion(versions ...string) (string, error) {
+	return PreferredVersion(c.SupportedVersions(), versions...)
+}
+
+// PreferredVersion returns the newest version among versions that is in
+// supported, or among supported when no versions are given. It returns an
+// UnsupportedVersionError when there is none.
+func PreferredVersion(supported []string, versions ...string) (string, error) {
+	if len(versions) == 0 {
+		versions = supported
+	}
+
+	preferred := ""
+	for _, version := range versions {
+		if !slices.Contains(supported, version) {
+			continue
+		}
+		if preferred == "" || compareVersions(version, preferred) > 0 {
+			preferred = version
+		}
+	}
+	if preferred == "" {
+		return "", &apierrors.UnsupportedVersionError{
+			Version:   strings.Join(versions, ","),
+			Supported: slices.Clone(supported),
+		}
+	}
+	return preferred, nil
+}
+
+// checkVersion returns an UnsupportedVersionError if the plugin serving
+// objectType does not serve its version, or the error of the last failed discovery on
+// SetServer. All versions are allowed until they are discovered.
+func (c *client) checkVersion(objectType object.ObjectType) error {
+	version := ObjectTypeVersion(objectType)
+	if version == "" {
+		return nil
+	}
+
+	c.versionsMu.RLock()
+	defer c.versionsMu.RUnlock()
+
+	if c.versionsErr != nil {
+		return c.versionsErr
+	}
+	if c.supportedVersions == nil {
+		return nil
+	}
+	supported := c.supportedVersions[objectTypePlugin(objectType)]
+	if slices.Contains(supported, version) {
+		return nil
+	}
+	return &apierrors.UnsupportedVersionError{
+		Version:   version,
+		Supported: slices.Clone(supported),
+	}
+}
+
+// objectTypePlugin returns the plugin serving objectType.
+func objectTypePlugin(objectType object.ObjectType) string {
+	if slurmdbObjectTypes.Has(objectType) {
+		return pluginSlurmdb
+	}
+	return pluginSlurm
+}
+
+// resetVersions forgets the discovered versions, allowing all versions again.
+func (c *client) resetVersions() {
+	c.versionsMu.Lock()
+	defer c.versionsMu.Unlock()
+	c.supportedVersions = nil
+	c.versionsErr = nil
+}
+
+// fetchVersions returns the versions of the plugin paths in the OpenAPI
+// specification of the server, by plugin.
+func (c *client) fetchVersions(ctx context.Context) (map[string][]string, error) {
+	url := strings.TrimSuffix(c.config.Server, "/") + openapiSpecPath
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	token, err := c.resolveToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve auth token: %w", err)
+	}
+	req.Header.Set(headerSlurmUserToken, token)
+
+	httpClient := http.DefaultClient
+	if c.config.HTTPClient != nil {
+		httpClient = c.config.HTTPClient
+	}
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close() //nolint:errcheck
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", openapiSpecPath, http.StatusText(res.StatusCode))
+	}
+
+	spec := struct {
+		Paths map[string]json.RawMessage `json:"paths"`
+	}{}
+	if err := json.NewDecoder(res.Body).Decode(&spec); err != nil {
+		return nil, fmt.Errorf("unable to decode %s: %w", openapiSpecPath, err)
+	}
+
+	versions := map[string][]string{}
+	for path := range spec.Paths {
+		matches := versionPathRegex.FindStringSubmatch(path)
+		if len(matches) < 3 {
+			continue
+		}
+		plugin, version := matches[1], matches[2]
+		if slices.Contains(versions[plugin], version) {
+			continue
+		}
+		versions[plugin] = append(versions[plugin], version)
+	}
+	for _, served := range versions {
+		slices.SortFunc(served, compareVersions)
+	}
+	return versions, nil
+}
+
+// compareVersions compares two "vX.Y.Z" versions.
+func compareVersions(a, b string) int {
+	var aParts, bParts [3]int
+	_, _ = fmt.Sscanf(a, "v%d.%d.%d", &aParts[0], &aParts[1], &aParts[2])
+	_, _ = fmt.Sscanf(b, "v%d.%d.%d", &bParts[0], &bParts[1], &bParts[2])
+	return slices.Compare(aParts[:], bParts[:])
+}
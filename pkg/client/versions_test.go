@@ -0,0 +1,267 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/SlinkyProject/slurm-client/pkg/client/token"
+	apierrors "github.com/SlinkyProject/slurm-client/pkg/errors"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+)
+
+const testOpenapiSpec = `{
+	"openapi": "3.0.2",
+	"paths": {
+		"/openapi/v3": {},
+		"/slurm/v0.0.44/ping/": {},
+		"/slurm/v0.0.45/ping/": {},
+		"/slurmdb/v0.0.45/diag/": {},
+		"/slurm/v0.0.99/ping/": {}
+	}
+}`
+
+// newVersionsServer returns a server serving spec, recording the token of
+// each request.
+func newVersionsServer(t *testing.T, status int, spec string, tokens *[]string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		*tokens = append(*tokens, req.Header.Get(headerSlurmUserToken))
+		if req.URL.Path != openapiSpecPath {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(spec))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestObjectTypeVersion(t *testing.T) {
+	tests := []struct {
+		name       string
+		objectType object.ObjectType
+		want       string
+	}{
+		{
+			name:       "v0042",
+			objectType: types.ObjectTypeV0042Node,
+			want:       VersionV0042,
+		},
+		{
+			name:       "v0045",
+			objectType: types.ObjectTypeV0045AccountingJob,
+			want:       VersionV0045,
+		},
+		{
+			name:       "unknown version",
+			objectType: "V0099Node",
+			want:       "",
+		},
+		{
+			name:       "unversioned",
+			objectType: "Node",
+			want:       "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ObjectTypeVersion(tt.objectType)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPreferredVersion(t *testing.T) {
+	tests := []struct {
+		name      string
+		supported []string
+		versions  []string
+		want      string
+		wantErr   bool
+	}{
+		{
+			name:      "newest supported",
+			supported: []string{VersionV0043, VersionV0044},
+			want:      VersionV0044,
+		},
+		{
+			name:      "newest requested",
+			supported: []string{VersionV0043, VersionV0044, VersionV0045},
+			versions:  []string{VersionV0045, VersionV0042, VersionV0043},
+			want:      VersionV0045,
+		},
+		{
+			name:      "skip unsupported",
+			supported: []string{VersionV0043, VersionV0044},
+			versions:  []string{VersionV0042, VersionV0043, VersionV0045},
+			want:      VersionV0043,
+		},
+		{
+			name:      "none supported",
+			supported: []string{VersionV0044},
+			versions:  []string{VersionV0042, VersionV0043},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PreferredVersion(tt.supported, tt.versions...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PreferredVersion() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				require.True(t, apierrors.IsUnsupportedVersion(err))
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_DiscoverVersions(t *testing.T) {
+	tokens := []string{}
+	server := newVersionsServer(t, http.StatusOK, testOpenapiSpec, &tokens)
+
+	slurmClient, err := NewClient(&Config{
+		Server:        server.URL,
+		TokenProvider: token.StaticProvider("foo"),
+	})
+	require.NoError(t, err)
+
+	// All known versions are assumed before discovery.
+	require.Equal(t, KnownVersions(), slurmClient.SupportedVersions())
+
+	require.NoError(t, slurmClient.DiscoverVersions(context.Background()))
+	require.Equal(t, []string{"foo"}, tokens)
+	require.Equal(t, []string{VersionV0044, VersionV0045}, slurmClient.SupportedVersions())
+
+	preferred, err := slurmClient.PreferredVersion()
+	require.NoError(t, err)
+	require.Equal(t, VersionV0045, preferred)
+
+	preferred, err = slurmClient.PreferredVersion(VersionV0042, VersionV0044)
+	require.NoError(t, err)
+	require.Equal(t, VersionV0044, preferred)
+
+	// Requests of unsupported versions fail without calling the server.
+	err = slurmClient.Get(context.Background(), "node-0", &types.V0042Node{})
+	require.True(t, apierrors.IsUnsupportedVersion(err), err)
+	err = slurmClient.List(context.Background(), &types.V0043NodeList{})
+	require.True(t, apierrors.IsUnsupportedVersion(err), err)
+	require.Len(t, tokens, 1)
+
+	// Discovered versions are forgotten when the server changes.
+	slurmClient.SetServer(server.URL)
+	require.Equal(t, KnownVersions(), slurmClient.SupportedVersions())
+}
+
+func TestClient_DiscoverVersions_Plugins(t *testing.T) {
+	tokens := []string{}
+	spec := `{"paths": {"/slurm/v0.0.45/ping/": {}, "/slurmdb/v0.0.44/diag/": {}}}`
+	server := newVersionsServer(t, http.StatusOK, spec, &tokens)
+
+	slurmClient, err := NewClient(&Config{
+		Server:        server.URL,
+		TokenProvider: token.StaticProvider("foo"),
+	}, &ClientOptions{DiscoverVersions: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{VersionV0044, VersionV0045}, slurmClient.SupportedVersions())
+
+	// Each object type is checked against the versions of its plugin.
+	internalClient := slurmClient.(*client)
+	require.NoError(t, internalClient.checkVersion(types.ObjectTypeV0045Node))
+	err = internalClient.checkVersion(types.ObjectTypeV0045Account)
+	require.True(t, apierrors.IsUnsupportedVersion(err), err)
+	require.ErrorContains(t, err, VersionV0044)
+	err = internalClient.checkVersion(types.ObjectTypeV0044Node)
+	require.True(t, apierrors.IsUnsupportedVersion(err), err)
+}
+
+func TestClient_DiscoverVersions_Error(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		spec   string
+	}{
+		{
+			name:   "not found",
+			status: http.StatusNotFound,
+		},
+		{
+			name:   "invalid spec",
+			status: http.StatusOK,
+			spec:   "{",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens := []string{}
+			server := newVersionsServer(t, tt.status, tt.spec, &tokens)
+
+			slurmClient, err := NewClient(&Config{
+				Server:        server.URL,
+				TokenProvider: token.StaticProvider("foo"),
+			})
+			require.NoError(t, err)
+			require.Error(t, slurmClient.DiscoverVersions(context.Background()))
+			require.Equal(t, KnownVersions(), slurmClient.SupportedVersions())
+
+			_, err = NewClient(&Config{
+				Server:        server.URL,
+				TokenProvider: token.StaticProvider("foo"),
+			}, &ClientOptions{DiscoverVersions: true})
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestNewClient_DiscoverVersions(t *testing.T) {
+	tokens := []string{}
+	server := newVersionsServer(t, http.StatusOK, testOpenapiSpec, &tokens)
+
+	slurmClient, err := NewClient(&Config{
+		Server:        server.URL,
+		TokenProvider: token.StaticProvider("foo"),
+	}, &ClientOptions{DiscoverVersions: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{VersionV0044, VersionV0045}, slurmClient.SupportedVersions())
+}
+
+func TestClient_SetServer_DiscoverVersions(t *testing.T) {
+	tokens := []string{}
+	server := newVersionsServer(t, http.StatusOK, testOpenapiSpec, &tokens)
+	otherServer := newVersionsServer(t, http.StatusOK, `{"paths": {"/slurm/v0.0.43/ping/": {}}}`, &tokens)
+	badServer := newVersionsServer(t, http.StatusNotFound, "", &tokens)
+
+	slurmClient, err := NewClient(&Config{
+		Server:        server.URL,
+		TokenProvider: token.StaticProvider("foo"),
+	}, &ClientOptions{DiscoverVersions: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{VersionV0044, VersionV0045}, slurmClient.SupportedVersions())
+
+	// The versions of the new server are discovered.
+	slurmClient.SetServer(otherServer.URL)
+	require.Equal(t, []string{VersionV0043}, slurmClient.SupportedVersions())
+
+	// Requests fail with the discovery error when the new server cannot be
+	// probed, until discovery succeeds.
+	slurmClient.SetServer(badServer.URL)
+	require.Equal(t, KnownVersions(), slurmClient.SupportedVersions())
+	err = slurmClient.Get(context.Background(), "node-0", &types.V0045Node{})
+	require.ErrorContains(t, err, "unable to discover versions")
+	require.Error(t, slurmClient.DiscoverVersions(context.Background()))
+	err = slurmClient.Get(context.Background(), "node-0", &types.V0045Node{})
+	require.ErrorContains(t, err, "unable to discover versions")
+
+	slurmClient.SetServer(server.URL)
+	require.Equal(t, []string{VersionV0044, VersionV0045}, slurmClient.SupportedVersions())
+}
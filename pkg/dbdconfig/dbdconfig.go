@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package dbdconfig exports, compares and restores the slurmdbd accounting
+// configuration: clusters, TRES, QOS, accounts, users, associations and
+// wckeys.
+package dbdconfig
+
+import (
+	"context"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+)
+
+// Client reads and loads the slurmdbd accounting configuration. It is
+// implemented by the v0045 SlurmClient.
+type Client interface {
+	GetDbdConfig(ctx context.Context) (*api.V0045OpenapiSlurmdbdConfigResp, error)
+	UpdateDbdConfig(ctx context.Context, req any) error
+}
+
+// RestoreOptions configures Restore.
+type RestoreOptions struct {
+	// DryRun reports the changes without making them.
+	DryRun bool
+}
+
+// Export returns the accounting configuration of slurmdbd as a document.
+func Export(ctx context.Context, c Client) (*Document, error) {
+	config, err := c.GetDbdConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return NewDocument(config), nil
+}
+
+// Restore loads the objects of doc into slurmdbd, returning the changes made,
+// or that would be made with DryRun. Only objects that are missing or differ
+// are loaded, as they are in doc. slurmdbd does not remove objects on load, so
+// objects missing from doc are left in place and their Delete changes are
+// returned as skipped.
+func Restore(ctx context.Context, c Client, doc *Document, opts *RestoreOptions) ([]Change, error) {
+	if opts == nil {
+		opts = &RestoreOptions{}
+	}
+
+	current, err := Export(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, changed := diff(current, doc)
+	load := false
+	for i := range changes {
+		if changes[i].Type == ChangeTypeDelete {
+			changes[i].Skipped = true
+		} else {
+			load = true
+		}
+	}
+
+	if opts.DryRun || !load {
+		return changes, nil
+	}
+
+	if err := c.UpdateDbdConfig(ctx, changed.Config()); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
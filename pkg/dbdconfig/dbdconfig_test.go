@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package dbdconfig
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	v0045 "github.com/SlinkyProject/slurm-client/pkg/client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/client/api/v0045/fake"
+	"github.com/SlinkyProject/slurm-client/pkg/client/api/v0045/interceptor"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+)
+
+// newTestClient returns a client serving current and recording the last
+// configuration loaded into it.
+func newTestClient(current *Document, loaded *api.V0045OpenapiSlurmdbdConfigResp) Client {
+	return &v0045.SlurmClient{
+		ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+			WithInterceptorFuncs(interceptor.Funcs{
+				SlurmdbV0045GetConfigWithResponse: func(ctx context.Context, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetConfigResponse, error) {
+					config := current.Config()
+					res := &api.SlurmdbV0045GetConfigResponse{
+						HTTPResponse: &fake.HttpSuccess,
+						JSON200:      &config,
+					}
+					return res, nil
+				},
+				SlurmdbV0045PostConfigWithResponse: func(ctx context.Context, body api.V0045OpenapiSlurmdbdConfigResp, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045PostConfigResponse, error) {
+					*loaded = body
+					res := &api.SlurmdbV0045PostConfigResponse{
+						HTTPResponse: &fake.HttpSuccess,
+						JSON200:      &api.V0045OpenapiResp{},
+					}
+					return res, nil
+				},
+			}).
+			Build(),
+	}
+}
+
+func TestExport(t *testing.T) {
+	doc := newTestDocument()
+	c := newTestClient(doc, &api.V0045OpenapiSlurmdbdConfigResp{})
+	got, err := Export(context.Background(), c)
+	require.NoError(t, err)
+	require.Equal(t, doc, got)
+}
+
+func TestExport_Error(t *testing.T) {
+	c := &v0045.SlurmClient{
+		ClientWithResponsesInterface: fake.NewFakeClientBuilder().
+			WithInterceptorFuncs(interceptor.Funcs{
+				SlurmdbV0045GetConfigWithResponse: func(ctx context.Context, reqEditors ...api.RequestEditorFn) (*api.SlurmdbV0045GetConfigResponse, error) {
+					return nil, errors.New(http.StatusText(http.StatusBadGateway))
+				},
+			}).
+			Build(),
+	}
+	_, err := Export(context.Background(), c)
+	require.Error(t, err)
+}
+
+func TestRestore(t *testing.T) {
+	current := newTestDocument()
+	doc := current.DeepCopy()
+	doc.Qos[0].Description = ptr.To("200")
+	doc.Accounts = append(doc.Accounts, api.V0045Account{Name: "physics"})
+	doc.Users = nil
+	deleted := current.DeepCopy()
+	deleted.Users = nil
+
+	tests := []struct {
+		name       string
+		doc        *Document
+		opts       *RestoreOptions
+		want       []Change
+		wantLoaded api.V0045OpenapiSlurmdbdConfigResp
+	}{
+		{
+			name: "unchanged",
+			doc:  current,
+			want: []Change{},
+		},
+		{
+			name: "only deletes",
+			doc:  deleted,
+			want: []Change{
+				{Type: ChangeTypeDelete, ObjectType: types.ObjectTypeV0045User, Key: "alice", Skipped: true},
+			},
+		},
+		{
+			name: "dry run",
+			doc:  doc,
+			opts: &RestoreOptions{DryRun: true},
+			want: []Change{
+				{Type: ChangeTypeUpdate, ObjectType: types.ObjectTypeV0045Qos, Key: "normal"},
+				{Type: ChangeTypeCreate, ObjectType: types.ObjectTypeV0045Account, Key: "physics"},
+				{Type: ChangeTypeDelete, ObjectType: types.ObjectTypeV0045User, Key: "alice", Skipped: true},
+			},
+		},
+		{
+			name: "restore",
+			doc:  doc,
+			want: []Change{
+				{Type: ChangeTypeUpdate, ObjectType: types.ObjectTypeV0045Qos, Key: "normal"},
+				{Type: ChangeTypeCreate, ObjectType: types.ObjectTypeV0045Account, Key: "physics"},
+				{Type: ChangeTypeDelete, ObjectType: types.ObjectTypeV0045User, Key: "alice", Skipped: true},
+			},
+			wantLoaded: api.V0045OpenapiSlurmdbdConfigResp{
+				Qos:      &api.V0045QosList{{Name: ptr.To("normal"), Id: ptr.To[int32](1), Description: ptr.To("200")}},
+				Accounts: &api.V0045AccountList{{Name: "physics"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loaded := api.V0045OpenapiSlurmdbdConfigResp{}
+			c := newTestClient(current, &loaded)
+			got, err := Restore(context.Background(), c, tt.doc, tt.opts)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.wantLoaded, loaded)
+		})
+	}
+}
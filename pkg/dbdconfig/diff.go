@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package dbdconfig
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+)
+
+// ChangeType is the kind of change made to an object.
+type ChangeType string
+
+const (
+	ChangeTypeCreate ChangeType = "Create"
+	ChangeTypeUpdate ChangeType = "Update"
+	ChangeTypeDelete ChangeType = "Delete"
+)
+
+// Change describes one object that differs between two documents.
+type Change struct {
+	Type       ChangeType        `json:"type"`
+	ObjectType object.ObjectType `json:"objectType"`
+	Key        object.ObjectKey  `json:"key"`
+	// Skipped is set when the change was not, or would not be, made.
+	Skipped bool `json:"skipped,omitempty"`
+}
+
+// String returns the change as "<type> <objectType> <key>", followed by
+// " (skipped)" when it was skipped.
+func (c Change) String() string {
+	out := fmt.Sprintf("%s %s %s", c.Type, c.ObjectType, c.Key)
+	if c.Skipped {
+		out += " (skipped)"
+	}
+	return out
+}
+
+// Diff returns the changes that turn from into to, ordered by object type
+// then key. Objects are matched by name rather than by the IDs slurmdbd
+// assigns them, and IDs and usage are ignored when comparing objects, so that
+// documents exported from different slurmdbd can be compared.
+func Diff(from, to *Document) []Change {
+	changes, _ := diff(from, to)
+	return changes
+}
+
+// diff returns the changes from from to to, and a document holding the objects
+// of to that were created or updated. Objects are compared once normalized, but
+// returned as they are in to.
+func diff(from, to *Document) ([]Change, *Document) {
+	normFrom := normalize(from)
+	normTo := normalize(to)
+	changes := []Change{}
+	out := &Document{
+		Version:    DocumentVersion,
+		APIVersion: APIVersion,
+	}
+	var c []Change
+	c, out.Clusters = diffObjects(types.ObjectTypeV0045Cluster, normFrom.Clusters, normTo.Clusters, to.Clusters, clusterKey)
+	changes = append(changes, c...)
+	c, out.Tres = diffObjects(types.ObjectTypeV0045Tres, normFrom.Tres, normTo.Tres, to.Tres, tresKey)
+	changes = append(changes, c...)
+	c, out.Qos = diffObjects(types.ObjectTypeV0045Qos, normFrom.Qos, normTo.Qos, to.Qos, qosKey)
+	changes = append(changes, c...)
+	c, out.Accounts = diffObjects(types.ObjectTypeV0045Account, normFrom.Accounts, normTo.Accounts, to.Accounts, accountKey)
+	changes = append(changes, c...)
+	c, out.Users = diffObjects(types.ObjectTypeV0045User, normFrom.Users, normTo.Users, to.Users, userKey)
+	changes = append(changes, c...)
+	c, out.Associations = diffObjects(types.ObjectTypeV0045Association, normFrom.Associations, normTo.Associations, to.Associations, associationKey)
+	changes = append(changes, c...)
+	c, out.Wckeys = diffObjects(types.ObjectTypeV0045WCKey, normFrom.Wckeys, normTo.Wckeys, to.Wckeys, wckeyKey)
+	changes = append(changes, c...)
+	return changes, out
+}
+
+// diffObjects returns the changes from from to to, sorted by key, and the
+// objects of orig that were created or updated, orig being to before it was
+// normalized.
+func diffObjects[T any](
+	objectType object.ObjectType,
+	from, to, orig []T,
+	key func(*T) object.ObjectKey,
+) ([]Change, []T) {
+	existing := make(map[object.ObjectKey]*T, len(from))
+	for i := range from {
+		existing[key(&from[i])] = &from[i]
+	}
+
+	changes := []Change{}
+	var changed []T
+	for i := range to {
+		k := key(&to[i])
+		old, ok := existing[k]
+		delete(existing, k)
+		switch {
+		case !ok:
+			changes = append(changes, Change{Type: ChangeTypeCreate, ObjectType: objectType, Key: k})
+		case !reflect.DeepEqual(*old, to[i]):
+			changes = append(changes, Change{Type: ChangeTypeUpdate, ObjectType: objectType, Key: k})
+		default:
+			continue
+		}
+		changed = append(changed, orig[i])
+	}
+	for k := range existing {
+		changes = append(changes, Change{Type: ChangeTypeDelete, ObjectType: objectType, Key: k})
+	}
+
+	slices.SortFunc(changes, func(a, b Change) int {
+		return strings.Compare(string(a.Key), string(b.Key))
+	})
+	return changes, changed
+}
+
+// normalize returns a copy of doc without the fields that slurmdbd assigns or
+// updates on its own.
+func normalize(doc *Document) *Document {
+	out := doc.DeepCopy()
+	for i := range out.Clusters {
+		out.Clusters[i].Controller = nil
+		out.Clusters[i].RpcVersion = nil
+	}
+	for i := range out.Tres {
+		out.Tres[i].Id = nil
+	}
+	for i := range out.Qos {
+		out.Qos[i].Id = nil
+	}
+	for i := range out.Accounts {
+		clearAssocShortIds(out.Accounts[i].Associations)
+	}
+	for i := range out.Users {
+		clearAssocShortIds(out.Users[i].Associations)
+		if out.Users[i].Default != nil {
+			out.Users[i].Default.Qos = nil
+		}
+		if out.Users[i].Wckeys == nil {
+			continue
+		}
+		for j := range *out.Users[i].Wckeys {
+			(*out.Users[i].Wckeys)[j].Id = nil
+		}
+	}
+	for i := range out.Associations {
+		out.Associations[i].Id = nil
+		out.Associations[i].Accounting = nil
+	}
+	for i := range out.Wckeys {
+		out.Wckeys[i].Id = nil
+		out.Wckeys[i].Accounting = nil
+	}
+	return out
+}
+
+func clearAssocShortIds(assocs *api.V0045AssocShortList) {
+	if assocs == nil {
+		return
+	}
+	for i := range *assocs {
+		(*assocs)[i].Id = nil
+	}
+}
+
+func clusterKey(o *api.V0045ClusterRec) object.ObjectKey {
+	return (&types.V0045Cluster{V0045ClusterRec: *o}).GetKey()
+}
+
+func tresKey(o *api.V0045Tres) object.ObjectKey {
+	return (&types.V0045Tres{V0045Tres: *o}).GetKey()
+}
+
+func qosKey(o *api.V0045Qos) object.ObjectKey {
+	return (&types.V0045Qos{V0045Qos: *o}).GetKey()
+}
+
+func accountKey(o *api.V0045Account) object.ObjectKey {
+	return (&types.V0045Account{V0045Account: *o}).GetKey()
+}
+
+func userKey(o *api.V0045User) object.ObjectKey {
+	return (&types.V0045User{V0045User: *o}).GetKey()
+}
+
+func associationKey(o *api.V0045Assoc) object.ObjectKey {
+	return (&types.V0045Association{V0045Assoc: *o}).GetKey()
+}
+
+// wckeyKey identifies a wckey by "cluster/user/name", as its ID differs
+// between slurmdbd.
+func wckeyKey(o *api.V0045Wckey) object.ObjectKey {
+	return object.ObjectKey(strings.Join([]string{o.Cluster, o.User, o.Name}, "/"))
+}
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package dbdconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		from *Document
+		to   func(doc *Document)
+		want []Change
+	}{
+		{
+			name: "equal",
+			from: newTestDocument(),
+			to:   func(doc *Document) {},
+			want: []Change{},
+		},
+		{
+			name: "ids and usage are ignored",
+			from: newTestDocument(),
+			to: func(doc *Document) {
+				doc.Qos[0].Id = ptr.To[int32](10)
+				doc.Associations[0].Id = nil
+				doc.Associations[0].Accounting = &api.V0045AccountingList{{Id: ptr.To[int32](1)}}
+				doc.Clusters[0].RpcVersion = ptr.To[int32](11520)
+			},
+			want: []Change{},
+		},
+		{
+			name: "changes",
+			from: newTestDocument(),
+			to: func(doc *Document) {
+				doc.Qos[0].Description = ptr.To("updated")
+				doc.Accounts = append(doc.Accounts, api.V0045Account{Name: "physics"})
+				doc.Users = append(doc.Users, api.V0045User{Name: "bob"}, api.V0045User{Name: "anne"})
+				doc.Associations = nil
+				doc.Wckeys = []api.V0045Wckey{{Cluster: "linux", User: "alice", Name: "foo"}}
+			},
+			want: []Change{
+				{Type: ChangeTypeUpdate, ObjectType: types.ObjectTypeV0045Qos, Key: "normal"},
+				{Type: ChangeTypeCreate, ObjectType: types.ObjectTypeV0045Account, Key: "physics"},
+				{Type: ChangeTypeCreate, ObjectType: types.ObjectTypeV0045User, Key: "anne"},
+				{Type: ChangeTypeCreate, ObjectType: types.ObjectTypeV0045User, Key: "bob"},
+				{Type: ChangeTypeDelete, ObjectType: types.ObjectTypeV0045Association, Key: "linux/root/alice/"},
+				{Type: ChangeTypeCreate, ObjectType: types.ObjectTypeV0045WCKey, Key: "linux/alice/foo"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			to := tt.from.DeepCopy()
+			tt.to(to)
+			got := Diff(tt.from, to)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// newTestDbdDocument returns the same configuration as exported from a
+// slurmdbd that assigned ids starting at base.
+func newTestDbdDocument(base int32) *Document {
+	id := func(n int32) *int32 {
+		return ptr.To(base + n)
+	}
+	assocs := func(user string) *api.V0045AssocShortList {
+		return &api.V0045AssocShortList{
+			{Cluster: ptr.To("linux"), Account: ptr.To("root"), User: user, Id: id(3)},
+		}
+	}
+	return NewDocument(&api.V0045OpenapiSlurmdbdConfigResp{
+		Clusters: &api.V0045ClusterRecList{
+			{Name: ptr.To("linux")},
+		},
+		Tres: &api.V0045TresList{
+			{Type: "cpu", Id: id(0)},
+		},
+		Qos: &api.V0045QosList{
+			{Name: ptr.To("normal"), Id: id(1)},
+		},
+		Accounts: &api.V0045AccountList{
+			{Name: "root", Associations: assocs("")},
+		},
+		Users: &api.V0045UserList{
+			{
+				Name:         "alice",
+				Associations: assocs("alice"),
+				Default: &struct {
+					Account *string `json:"account,omitempty"`
+					Qos     *int32  `json:"qos,omitempty"`
+					Wckey   *string `json:"wckey,omitempty"`
+				}{Account: ptr.To("root"), Qos: id(1)},
+				Wckeys: &api.V0045WckeyList{
+					{Cluster: "linux", User: "alice", Name: "foo", Id: id(2)},
+				},
+			},
+		},
+		Associations: &api.V0045AssocList{
+			{Cluster: ptr.To("linux"), Account: ptr.To("root"), User: "alice", Id: id(3)},
+		},
+		Wckeys: &api.V0045WckeyList{
+			{Cluster: "linux", User: "alice", Name: "foo", Id: id(2)},
+		},
+	})
+}
+
+func TestDiff_TwoSlurmdbd(t *testing.T) {
+	from := newTestDbdDocument(1)
+	to := newTestDbdDocument(100)
+	require.Empty(t, Diff(from, to))
+	require.Empty(t, Diff(to, from))
+}
+
+func TestChange_String(t *testing.T) {
+	change := Change{Type: ChangeTypeCreate, ObjectType: types.ObjectTypeV0045Account, Key: "physics"}
+	require.Equal(t, "Create V0045Account physics", change.String())
+
+	change = Change{Type: ChangeTypeDelete, ObjectType: types.ObjectTypeV0045User, Key: "alice", Skipped: true}
+	require.Equal(t, "Delete V0045User alice (skipped)", change.String())
+}
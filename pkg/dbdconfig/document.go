@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package dbdconfig
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"go.yaml.in/yaml/v3"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+const (
+	// DocumentVersion is the schema version of documents written by this package.
+	DocumentVersion = "v1"
+	// APIVersion is the Slurm REST API version of the objects in a document.
+	APIVersion = "v0.0.45"
+)
+
+// Format is the encoding of a document.
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+)
+
+// Document is a versioned snapshot of the slurmdbd accounting configuration.
+type Document struct {
+	Version    string `json:"version"`
+	APIVersion string `json:"apiVersion"`
+
+	Clusters     []api.V0045ClusterRec `json:"clusters,omitempty"`
+	Tres         []api.V0045Tres       `json:"tres,omitempty"`
+	Qos          []api.V0045Qos        `json:"qos,omitempty"`
+	Accounts     []api.V0045Account    `json:"accounts,omitempty"`
+	Users        []api.V0045User       `json:"users,omitempty"`
+	Associations []api.V0045Assoc      `json:"associations,omitempty"`
+	Wckeys       []api.V0045Wckey      `json:"wckeys,omitempty"`
+}
+
+// NewDocument returns a document holding the objects of config.
+func NewDocument(config *api.V0045OpenapiSlurmdbdConfigResp) *Document {
+	doc := &Document{
+		Version:    DocumentVersion,
+		APIVersion: APIVersion,
+	}
+	if config == nil {
+		return doc
+	}
+	if config.Clusters != nil {
+		doc.Clusters = *config.Clusters
+	}
+	if config.Tres != nil {
+		doc.Tres = *config.Tres
+	}
+	if config.Qos != nil {
+		doc.Qos = *config.Qos
+	}
+	if config.Accounts != nil {
+		doc.Accounts = *config.Accounts
+	}
+	if config.Users != nil {
+		doc.Users = *config.Users
+	}
+	if config.Associations != nil {
+		doc.Associations = *config.Associations
+	}
+	if config.Wckeys != nil {
+		doc.Wckeys = *config.Wckeys
+	}
+	return doc
+}
+
+// Config returns the objects of the document as a slurmdbd configuration
+// request, omitting empty object lists.
+func (d *Document) Config() api.V0045OpenapiSlurmdbdConfigResp {
+	out := api.V0045OpenapiSlurmdbdConfigResp{}
+	if len(d.Clusters) > 0 {
+		out.Clusters = &d.Clusters
+	}
+	if len(d.Tres) > 0 {
+		out.Tres = &d.Tres
+	}
+	if len(d.Qos) > 0 {
+		out.Qos = &d.Qos
+	}
+	if len(d.Accounts) > 0 {
+		out.Accounts = &d.Accounts
+	}
+	if len(d.Users) > 0 {
+		out.Users = &d.Users
+	}
+	if len(d.Associations) > 0 {
+		out.Associations = &d.Associations
+	}
+	if len(d.Wckeys) > 0 {
+		out.Wckeys = &d.Wckeys
+	}
+	return out
+}
+
+// DeepCopy returns a copy of the document.
+func (d *Document) DeepCopy() *Document {
+	out := &Document{}
+	utils.RemarshalOrDie(d, out)
+	return out
+}
+
+// Marshal encodes the document in the given format.
+func Marshal(doc *Document, format Format) ([]byte, error) {
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	switch format {
+	case FormatJSON:
+		return data, nil
+	case FormatYAML:
+		// Decode the JSON as a YAML node tree, rather than into a map, to keep
+		// the field order. Reset the JSON flow style to get block style YAML.
+		node := &yaml.Node{}
+		if err := yaml.Unmarshal(data, node); err != nil {
+			return nil, err
+		}
+		resetStyle(node)
+		return yaml.Marshal(node)
+	default:
+		return nil, fmt.Errorf("unsupported document format %q", format)
+	}
+}
+
+// Unmarshal decodes a JSON or YAML document, returning an error if it was
+// written for another document or API version.
+func Unmarshal(data []byte) (*Document, error) {
+	// JSON is valid YAML, so both formats are decoded as YAML then
+	// re-encoded as JSON to apply the JSON field names of the API objects.
+	var raw any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	doc := &Document{}
+	if err := utils.Remarshal(raw, doc); err != nil {
+		return nil, err
+	}
+	if doc.Version != DocumentVersion {
+		return nil, fmt.Errorf("unsupported document version %q, expected %q", doc.Version, DocumentVersion)
+	}
+	if doc.APIVersion != APIVersion {
+		return nil, fmt.Errorf("unsupported document apiVersion %q, expected %q", doc.APIVersion, APIVersion)
+	}
+	return doc, nil
+}
+
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetStyle(child)
+	}
+}
@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package dbdconfig
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+)
+
+func newTestDocument() *Document {
+	return NewDocument(&api.V0045OpenapiSlurmdbdConfigResp{
+		Clusters: &api.V0045ClusterRecList{
+			{Name: ptr.To("linux")},
+		},
+		Qos: &api.V0045QosList{
+			{Name: ptr.To("normal"), Id: ptr.To[int32](1), Description: ptr.To("100")},
+		},
+		Accounts: &api.V0045AccountList{
+			{Name: "root", Description: "default root account", Organization: "root"},
+		},
+		Users: &api.V0045UserList{
+			{Name: "alice"},
+		},
+		Associations: &api.V0045AssocList{
+			{Cluster: ptr.To("linux"), Account: ptr.To("root"), User: "alice", Id: ptr.To[int32](2)},
+		},
+	})
+}
+
+func TestNewDocument(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *api.V0045OpenapiSlurmdbdConfigResp
+		want   *Document
+	}{
+		{
+			name:   "nil",
+			config: nil,
+			want:   &Document{Version: DocumentVersion, APIVersion: APIVersion},
+		},
+		{
+			name: "objects",
+			config: &api.V0045OpenapiSlurmdbdConfigResp{
+				Accounts: &api.V0045AccountList{{Name: "root"}},
+				Wckeys:   &api.V0045WckeyList{{Cluster: "linux", User: "alice", Name: "foo"}},
+			},
+			want: &Document{
+				Version:    DocumentVersion,
+				APIVersion: APIVersion,
+				Accounts:   []api.V0045Account{{Name: "root"}},
+				Wckeys:     []api.V0045Wckey{{Cluster: "linux", User: "alice", Name: "foo"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewDocument(tt.config)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDocument_Config(t *testing.T) {
+	doc := &Document{
+		Accounts: []api.V0045Account{{Name: "root"}},
+	}
+	want := api.V0045OpenapiSlurmdbdConfigResp{
+		Accounts: &api.V0045AccountList{{Name: "root"}},
+	}
+	require.Equal(t, want, doc.Config())
+}
+
+func TestMarshal(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  Format
+		prefix  string
+		wantErr bool
+	}{
+		{
+			name:   "json",
+			format: FormatJSON,
+			prefix: "{\n  \"version\": \"v1\",\n  \"apiVersion\": \"v0.0.45\",\n",
+		},
+		{
+			name:   "yaml",
+			format: FormatYAML,
+			prefix: "version: v1\napiVersion: v0.0.45\n",
+		},
+		{
+			name:    "unknown",
+			format:  "xml",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := newTestDocument()
+			data, err := Marshal(doc, tt.format)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Marshal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			require.True(t, strings.HasPrefix(string(data), tt.prefix), string(data))
+
+			got, err := Unmarshal(data)
+			require.NoError(t, err)
+			require.Equal(t, doc, got)
+		})
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    *Document
+		wantErr bool
+	}{
+		{
+			name: "json",
+			data: `{"version": "v1", "apiVersion": "v0.0.45", "accounts": [{"name": "root"}]}`,
+			want: &Document{
+				Version:    DocumentVersion,
+				APIVersion: APIVersion,
+				Accounts:   []api.V0045Account{{Name: "root"}},
+			},
+		},
+		{
+			name: "yaml",
+			data: "version: v1\napiVersion: v0.0.45\naccounts:\n- name: root\n",
+			want: &Document{
+				Version:    DocumentVersion,
+				APIVersion: APIVersion,
+				Accounts:   []api.V0045Account{{Name: "root"}},
+			},
+		},
+		{
+			name:    "unsupported version",
+			data:    "version: v2\napiVersion: v0.0.45\n",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported apiVersion",
+			data:    "version: v1\napiVersion: v0.0.44\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid",
+			data:    "version: [",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Unmarshal([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
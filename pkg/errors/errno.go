@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package errors
+
+// Errno is a Slurm error number, as reported in the error_number of a Slurm
+// REST API error.
+type Errno int32
+
+// Slurm error numbers, named as in slurm/slurm_errno.h.
+const (
+	SLURM_SUCCESS Errno = 0
+	SLURM_ERROR   Errno = -1
+
+	SLURM_COMMUNICATIONS_CONNECTION_ERROR Errno = 1001
+	SLURM_COMMUNICATIONS_SEND_ERROR       Errno = 1002
+	SLURM_COMMUNICATIONS_RECEIVE_ERROR    Errno = 1003
+	SLURM_COMMUNICATIONS_SHUTDOWN_ERROR   Errno = 1004
+	SLURM_PROTOCOL_AUTHENTICATION_ERROR   Errno = 1007
+
+	SLURMCTLD_COMMUNICATIONS_CONNECTION_ERROR Errno = 1800
+	SLURMCTLD_COMMUNICATIONS_SEND_ERROR       Errno = 1801
+	SLURMCTLD_COMMUNICATIONS_RECEIVE_ERROR    Errno = 1802
+	SLURMCTLD_COMMUNICATIONS_SHUTDOWN_ERROR   Errno = 1803
+	SLURMCTLD_COMMUNICATIONS_BACKOFF          Errno = 1804
+
+	ESLURM_INVALID_PARTITION_NAME     Errno = 2000
+	ESLURM_ACCESS_DENIED              Errno = 2002
+	ESLURM_USER_ID_MISSING            Errno = 2010
+	ESLURM_NODES_BUSY                 Errno = 2016
+	ESLURM_INVALID_JOB_ID             Errno = 2017
+	ESLURM_INVALID_NODE_NAME          Errno = 2018
+	ESLURM_TRANSITION_STATE_NO_UPDATE Errno = 2020
+	ESLURM_ALREADY_DONE               Errno = 2021
+	ESLURM_IN_STANDBY_MODE            Errno = 2027
+	ESLURM_RESERVATION_INVALID        Errno = 2052
+	ESLURM_RESERVATION_BUSY           Errno = 2054
+	ESLURM_PORTS_BUSY                 Errno = 2058
+	ESLURM_INTERCONNECT_BUSY          Errno = 2078
+
+	ESLURM_DB_CONNECTION Errno = 7000
+
+	ESLURM_REST_EMPTY_RESULT Errno = 9003
+)
+
+var (
+	// notFoundErrnos report that the requested object does not exist.
+	notFoundErrnos = []Errno{
+		ESLURM_INVALID_JOB_ID,
+		ESLURM_INVALID_NODE_NAME,
+		ESLURM_INVALID_PARTITION_NAME,
+		ESLURM_RESERVATION_INVALID,
+	}
+
+	// accessDeniedErrnos report that the user may not make the request.
+	accessDeniedErrnos = []Errno{
+		ESLURM_ACCESS_DENIED,
+		ESLURM_USER_ID_MISSING,
+		SLURM_PROTOCOL_AUTHENTICATION_ERROR,
+	}
+
+	// busyErrnos report that Slurm cannot make the request right now.
+	busyErrnos = []Errno{
+		ESLURM_NODES_BUSY,
+		ESLURM_TRANSITION_STATE_NO_UPDATE,
+		ESLURM_IN_STANDBY_MODE,
+		ESLURM_RESERVATION_BUSY,
+		ESLURM_PORTS_BUSY,
+		ESLURM_INTERCONNECT_BUSY,
+	}
+
+	// unavailableErrnos report that a Slurm daemon could not be reached.
+	unavailableErrnos = []Errno{
+		SLURM_COMMUNICATIONS_CONNECTION_ERROR,
+		SLURM_COMMUNICATIONS_SEND_ERROR,
+		SLURM_COMMUNICATIONS_RECEIVE_ERROR,
+		SLURM_COMMUNICATIONS_SHUTDOWN_ERROR,
+		SLURMCTLD_COMMUNICATIONS_CONNECTION_ERROR,
+		SLURMCTLD_COMMUNICATIONS_SEND_ERROR,
+		SLURMCTLD_COMMUNICATIONS_RECEIVE_ERROR,
+		SLURMCTLD_COMMUNICATIONS_SHUTDOWN_ERROR,
+		SLURMCTLD_COMMUNICATIONS_BACKOFF,
+		ESLURM_DB_CONNECTION,
+	}
+)
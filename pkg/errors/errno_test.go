@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package errors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestErrno checks the values against slurm/slurm_errno.h, as the error numbers
+// reported by Slurm are only compared against these constants.
+func TestErrno(t *testing.T) {
+	tests := []struct {
+		name  string
+		errno Errno
+		want  int32
+	}{
+		{name: "SLURM_SUCCESS", errno: SLURM_SUCCESS, want: 0},
+		{name: "SLURM_ERROR", errno: SLURM_ERROR, want: -1},
+		{name: "SLURM_COMMUNICATIONS_CONNECTION_ERROR", errno: SLURM_COMMUNICATIONS_CONNECTION_ERROR, want: 1001},
+		{name: "SLURM_COMMUNICATIONS_SEND_ERROR", errno: SLURM_COMMUNICATIONS_SEND_ERROR, want: 1002},
+		{name: "SLURM_COMMUNICATIONS_RECEIVE_ERROR", errno: SLURM_COMMUNICATIONS_RECEIVE_ERROR, want: 1003},
+		{name: "SLURM_COMMUNICATIONS_SHUTDOWN_ERROR", errno: SLURM_COMMUNICATIONS_SHUTDOWN_ERROR, want: 1004},
+		{name: "SLURM_PROTOCOL_AUTHENTICATION_ERROR", errno: SLURM_PROTOCOL_AUTHENTICATION_ERROR, want: 1007},
+		{name: "SLURMCTLD_COMMUNICATIONS_CONNECTION_ERROR", errno: SLURMCTLD_COMMUNICATIONS_CONNECTION_ERROR, want: 1800},
+		{name: "SLURMCTLD_COMMUNICATIONS_SEND_ERROR", errno: SLURMCTLD_COMMUNICATIONS_SEND_ERROR, want: 1801},
+		{name: "SLURMCTLD_COMMUNICATIONS_RECEIVE_ERROR", errno: SLURMCTLD_COMMUNICATIONS_RECEIVE_ERROR, want: 1802},
+		{name: "SLURMCTLD_COMMUNICATIONS_SHUTDOWN_ERROR", errno: SLURMCTLD_COMMUNICATIONS_SHUTDOWN_ERROR, want: 1803},
+		{name: "SLURMCTLD_COMMUNICATIONS_BACKOFF", errno: SLURMCTLD_COMMUNICATIONS_BACKOFF, want: 1804},
+		{name: "ESLURM_INVALID_PARTITION_NAME", errno: ESLURM_INVALID_PARTITION_NAME, want: 2000},
+		{name: "ESLURM_ACCESS_DENIED", errno: ESLURM_ACCESS_DENIED, want: 2002},
+		{name: "ESLURM_USER_ID_MISSING", errno: ESLURM_USER_ID_MISSING, want: 2010},
+		{name: "ESLURM_NODES_BUSY", errno: ESLURM_NODES_BUSY, want: 2016},
+		{name: "ESLURM_INVALID_JOB_ID", errno: ESLURM_INVALID_JOB_ID, want: 2017},
+		{name: "ESLURM_INVALID_NODE_NAME", errno: ESLURM_INVALID_NODE_NAME, want: 2018},
+		{name: "ESLURM_TRANSITION_STATE_NO_UPDATE", errno: ESLURM_TRANSITION_STATE_NO_UPDATE, want: 2020},
+		{name: "ESLURM_ALREADY_DONE", errno: ESLURM_ALREADY_DONE, want: 2021},
+		{name: "ESLURM_IN_STANDBY_MODE", errno: ESLURM_IN_STANDBY_MODE, want: 2027},
+		{name: "ESLURM_RESERVATION_INVALID", errno: ESLURM_RESERVATION_INVALID, want: 2052},
+		{name: "ESLURM_RESERVATION_BUSY", errno: ESLURM_RESERVATION_BUSY, want: 2054},
+		{name: "ESLURM_PORTS_BUSY", errno: ESLURM_PORTS_BUSY, want: 2058},
+		{name: "ESLURM_INTERCONNECT_BUSY", errno: ESLURM_INTERCONNECT_BUSY, want: 2078},
+		{name: "ESLURM_DB_CONNECTION", errno: ESLURM_DB_CONNECTION, want: 7000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, int32(tt.errno))
+		})
+	}
+}
@@ -5,10 +5,150 @@ package errors
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
+	"slices"
+	"strings"
 )
 
 var (
 	ErrObjectNotFound = errors.New(http.StatusText(http.StatusNotFound))
 	ErrNotImplemented = errors.New(http.StatusText(http.StatusNotImplemented))
 )
+
+// UnsupportedVersionError is returned for requests of a Slurm REST API version
+// that the server does not serve.
+type UnsupportedVersionError struct {
+	// Version is the requested version.
+	Version string
+	// Supported are the versions served by the server.
+	Supported []string
+}
+
+// Error implements error.
+func (e *UnsupportedVersionError) Error() string {
+	return fmt.Sprintf("version %s is not supported by the server, supported versions: [%s]",
+		e.Version, strings.Join(e.Supported, ", "))
+}
+
+// IsUnsupportedVersion returns true if err is, or wraps, an
+// UnsupportedVersionError.
+func IsUnsupportedVersion(err error) bool {
+	var target *UnsupportedVersionError
+	return errors.As(err, &target)
+}
+
+// SlurmErrorEntry is an error reported by Slurm in a response.
+type SlurmErrorEntry struct {
+	// ErrorNumber is the Slurm error number.
+	ErrorNumber Errno
+	// Error is the short form error description.
+	Error string
+	// Description is the long form error description.
+	Description string
+	// Source is where the error was first detected.
+	Source string
+}
+
+// SlurmError is returned for a failed Slurm REST API request. It matches
+// ErrObjectNotFound when Slurm reports that the object does not exist.
+type SlurmError struct {
+	// StatusCode is the HTTP status code of the response, or zero for the
+	// error of a single job of a successful request.
+	StatusCode int
+	// Errors are the errors reported by Slurm.
+	Errors []SlurmErrorEntry
+}
+
+// Error implements error.
+func (e *SlurmError) Error() string {
+	msgs := []string{}
+	if e.StatusCode != 0 {
+		msgs = append(msgs, http.StatusText(e.StatusCode))
+	}
+	for _, entry := range e.Errors {
+		if entry.Error != "" {
+			msgs = append(msgs, entry.Error)
+		}
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Is reports whether e matches target.
+func (e *SlurmError) Is(target error) bool {
+	return target == ErrObjectNotFound && e.HasErrno(notFoundErrnos...)
+}
+
+// HasErrno returns true if Slurm reported any of errnos.
+func (e *SlurmError) HasErrno(errnos ...Errno) bool {
+	for _, entry := range e.Errors {
+		if slices.Contains(errnos, entry.ErrorNumber) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasErrno returns true if err is, or wraps, a SlurmError reporting any of
+// errnos.
+func HasErrno(err error, errnos ...Errno) bool {
+	var target *SlurmError
+	return errors.As(err, &target) && target.HasErrno(errnos...)
+}
+
+// IsNotFound returns true if err reports that the object does not exist. HTTP
+// 404 is not considered, as slurmrestd returns it for unknown paths.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrObjectNotFound)
+}
+
+// IsInvalidJobID returns true if Slurm reported an invalid or unknown job ID.
+func IsInvalidJobID(err error) bool {
+	return HasErrno(err, ESLURM_INVALID_JOB_ID)
+}
+
+// IsAccessDenied returns true if err reports that the user is not allowed to
+// make the request.
+func IsAccessDenied(err error) bool {
+	var target *SlurmError
+	if !errors.As(err, &target) {
+		return false
+	}
+	switch target.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return true
+	}
+	return target.HasErrno(accessDeniedErrnos...)
+}
+
+// IsBusy returns true if err reports that Slurm is too busy to make the
+// request right now.
+func IsBusy(err error) bool {
+	var target *SlurmError
+	if !errors.As(err, &target) {
+		return false
+	}
+	switch target.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	}
+	return target.HasErrno(busyErrnos...)
+}
+
+// IsRetryable returns true if err reports a transient failure, where Slurm is
+// busy or a Slurm daemon could not be reached, so the request may succeed if
+// retried.
+func IsRetryable(err error) bool {
+	if IsBusy(err) {
+		return true
+	}
+	var target *SlurmError
+	if !errors.As(err, &target) {
+		return false
+	}
+	switch target.StatusCode {
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return true
+	}
+	return target.HasErrno(unavailableErrnos...)
+}
@@ -0,0 +1,219 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSlurmError(statusCode int, errnos ...Errno) error {
+	err := &SlurmError{StatusCode: statusCode}
+	for _, errno := range errnos {
+		err.Errors = append(err.Errors, SlurmErrorEntry{
+			ErrorNumber: errno,
+			Error:       fmt.Sprintf("error %d", errno),
+		})
+	}
+	return err
+}
+
+func TestSlurmError_Error(t *testing.T) {
+	err := &SlurmError{
+		StatusCode: http.StatusInternalServerError,
+		Errors: []SlurmErrorEntry{
+			{ErrorNumber: ESLURM_INVALID_JOB_ID, Error: "Invalid job id specified"},
+			{Description: "no short form"},
+			{Error: "error 2"},
+		},
+	}
+	want := "Internal Server Error\nInvalid job id specified\nerror 2"
+	require.Equal(t, want, err.Error())
+
+	// The error of a single job has no status code.
+	err = &SlurmError{
+		Errors: []SlurmErrorEntry{
+			{ErrorNumber: ESLURM_INVALID_JOB_ID, Error: "Invalid job id specified"},
+		},
+	}
+	require.Equal(t, "Invalid job id specified", err.Error())
+	require.True(t, IsNotFound(err))
+}
+
+func TestHasErrno(t *testing.T) {
+	err := newTestSlurmError(http.StatusInternalServerError, SLURM_ERROR, ESLURM_INVALID_NODE_NAME)
+	require.True(t, HasErrno(err, ESLURM_INVALID_NODE_NAME))
+	require.True(t, HasErrno(fmt.Errorf("wrapped: %w", err), ESLURM_INVALID_JOB_ID, SLURM_ERROR))
+	require.False(t, HasErrno(err, ESLURM_INVALID_JOB_ID))
+	require.False(t, HasErrno(errors.New("error"), SLURM_ERROR))
+	require.False(t, HasErrno(nil, SLURM_ERROR))
+}
+
+func TestIsNotFound(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "ErrObjectNotFound",
+			err:  ErrObjectNotFound,
+			want: true,
+		},
+		{
+			name: "invalid job id",
+			err:  newTestSlurmError(http.StatusInternalServerError, ESLURM_INVALID_JOB_ID),
+			want: true,
+		},
+		{
+			name: "wrapped invalid node name",
+			err:  fmt.Errorf("wrapped: %w", newTestSlurmError(http.StatusInternalServerError, ESLURM_INVALID_NODE_NAME)),
+			want: true,
+		},
+		{
+			name: "invalid reservation",
+			err:  newTestSlurmError(http.StatusInternalServerError, ESLURM_RESERVATION_INVALID),
+			want: true,
+		},
+		{
+			name: "HTTP not found",
+			err:  newTestSlurmError(http.StatusNotFound),
+			want: false,
+		},
+		{
+			name: "other errno",
+			err:  newTestSlurmError(http.StatusInternalServerError, ESLURM_ACCESS_DENIED),
+			want: false,
+		},
+		{
+			name: "nil",
+			err:  nil,
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, IsNotFound(tt.err))
+		})
+	}
+}
+
+func TestIsInvalidJobID(t *testing.T) {
+	require.True(t, IsInvalidJobID(newTestSlurmError(http.StatusInternalServerError, ESLURM_INVALID_JOB_ID)))
+	require.False(t, IsInvalidJobID(newTestSlurmError(http.StatusInternalServerError, ESLURM_INVALID_NODE_NAME)))
+	require.False(t, IsInvalidJobID(ErrObjectNotFound))
+}
+
+func TestIsAccessDenied(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "access denied",
+			err:  newTestSlurmError(http.StatusInternalServerError, ESLURM_ACCESS_DENIED),
+			want: true,
+		},
+		{
+			name: "authentication error",
+			err:  newTestSlurmError(http.StatusInternalServerError, SLURM_PROTOCOL_AUTHENTICATION_ERROR),
+			want: true,
+		},
+		{
+			name: "HTTP unauthorized",
+			err:  newTestSlurmError(http.StatusUnauthorized),
+			want: true,
+		},
+		{
+			name: "HTTP forbidden",
+			err:  newTestSlurmError(http.StatusForbidden),
+			want: true,
+		},
+		{
+			name: "other errno",
+			err:  newTestSlurmError(http.StatusInternalServerError, ESLURM_INVALID_JOB_ID),
+			want: false,
+		},
+		{
+			name: "not a SlurmError",
+			err:  errors.New(http.StatusText(http.StatusForbidden)),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, IsAccessDenied(tt.err))
+		})
+	}
+}
+
+func TestIsBusy(t *testing.T) {
+	tests := []struct {
+		name          string
+		err           error
+		wantBusy      bool
+		wantRetryable bool
+	}{
+		{
+			name:          "nodes busy",
+			err:           newTestSlurmError(http.StatusInternalServerError, ESLURM_NODES_BUSY),
+			wantBusy:      true,
+			wantRetryable: true,
+		},
+		{
+			name:          "HTTP service unavailable",
+			err:           newTestSlurmError(http.StatusServiceUnavailable),
+			wantBusy:      true,
+			wantRetryable: true,
+		},
+		{
+			name:          "slurmctld connection error",
+			err:           newTestSlurmError(http.StatusInternalServerError, SLURMCTLD_COMMUNICATIONS_CONNECTION_ERROR),
+			wantBusy:      false,
+			wantRetryable: true,
+		},
+		{
+			name:          "slurmdbd connection error",
+			err:           newTestSlurmError(http.StatusInternalServerError, ESLURM_DB_CONNECTION),
+			wantBusy:      false,
+			wantRetryable: true,
+		},
+		{
+			name:          "HTTP bad gateway",
+			err:           newTestSlurmError(http.StatusBadGateway),
+			wantBusy:      false,
+			wantRetryable: true,
+		},
+		{
+			name:          "invalid job id",
+			err:           newTestSlurmError(http.StatusInternalServerError, ESLURM_INVALID_JOB_ID),
+			wantBusy:      false,
+			wantRetryable: false,
+		},
+		{
+			name:          "not a SlurmError",
+			err:           errors.New("error"),
+			wantBusy:      false,
+			wantRetryable: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantBusy, IsBusy(tt.err))
+			require.Equal(t, tt.wantRetryable, IsRetryable(tt.err))
+		})
+	}
+}
+
+func TestIsUnsupportedVersion(t *testing.T) {
+	err := &UnsupportedVersionError{Version: "v0.0.42", Supported: []string{"v0.0.44", "v0.0.45"}}
+	require.Equal(t, "version v0.0.42 is not supported by the server, supported versions: [v0.0.44, v0.0.45]", err.Error())
+	require.True(t, IsUnsupportedVersion(fmt.Errorf("wrapped: %w", err)))
+	require.False(t, IsUnsupportedVersion(ErrObjectNotFound))
+}
@@ -0,0 +1,245 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package hub provides version-agnostic representations of Slurm objects, and
+// conversions between them and each versioned type in pkg/types, so that
+// logic can be written once and the data parser version picked at runtime.
+//
+// Hub types embed the newest data parser version. Fields of an older version
+// that the hub cannot represent are kept in Extra, which makes converting to
+// the hub and back lossless. Converting from the hub reports the fields the
+// target version cannot represent.
+package hub
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+)
+
+// Extra holds the JSON fields of a source object that the hub type could not
+// represent, keyed by their JSON name.
+type Extra map[string]any
+
+// DeepCopy returns a copy of the fields.
+func (e Extra) DeepCopy() Extra {
+	if e == nil {
+		return nil
+	}
+	out, _ := merge(nil, map[string]any(e)).(map[string]any)
+	return out
+}
+
+// UnknownTypeError is returned when converting an object type that has no
+// representation as the hub type.
+type UnknownTypeError struct {
+	// ObjectType is the type of the object converted.
+	ObjectType object.ObjectType
+}
+
+// Error implements error.
+func (e *UnknownTypeError) Error() string {
+	return fmt.Sprintf("object type %s cannot be converted", e.ObjectType)
+}
+
+// UnknownVersionError is returned when converting to a version that has no
+// representation of the hub type.
+type UnknownVersionError struct {
+	// Version is the requested version.
+	Version string
+}
+
+// Error implements error.
+func (e *UnknownVersionError) Error() string {
+	return fmt.Sprintf("version %s cannot be converted to", e.Version)
+}
+
+// convert decodes the JSON representation of in, overlaid on the fields of
+// extra that in cannot represent, into out. It returns the fields that out
+// could not represent, both as Extra and as sorted JSON paths (e.g.
+// "power.current_watts", "nodes[0].name").
+func convert(in any, extra Extra, out any) (Extra, []string) {
+	tree := toTree(in)
+	if len(extra) > 0 {
+		// The fields of extra that in can represent are stale, as in may have
+		// been changed or cleared since, so they are dropped.
+		extraTree := toTree(map[string]any(extra))
+		hub := reflect.New(reflect.TypeOf(in)).Interface()
+		unknown, _ := prune(extraTree, represent(extraTree, hub), "", &[]string{})
+		tree = merge(unknown, tree)
+	}
+
+	missing := []string{}
+	lost, ok := prune(tree, represent(tree, out), "", &missing)
+	if !ok {
+		return nil, missing
+	}
+	slices.Sort(missing)
+	return Extra(lost.(map[string]any)), missing
+}
+
+// represent decodes tree into out and returns the JSON representation of out.
+// Values that do not fit the type of their field are skipped, and the
+// remainder is decoded.
+func represent(tree any, out any) any {
+	// Skipped values may leave allocated but empty fields behind, so only the
+	// values decoded intact are decoded again.
+	decode(tree, out)
+	kept, _ := intersect(tree, toTree(out))
+	reflect.ValueOf(out).Elem().SetZero()
+	decode(kept, out)
+	return toTree(out)
+}
+
+// decode unmarshals the JSON representation of tree into out, skipping the
+// values that do not fit the type of their field.
+func decode(tree any, out any) {
+	data, err := json.Marshal(tree)
+	if err != nil {
+		panic(err)
+	}
+	_ = json.Unmarshal(data, out)
+}
+
+// intersect returns the parts of src that are equal in dst, and whether there
+// are any.
+func intersect(src, dst any) (any, bool) {
+	switch src := src.(type) {
+	case map[string]any:
+		dst, ok := dst.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		out := map[string]any{}
+		for key, value := range src {
+			if dstValue, ok := dst[key]; ok {
+				if v, ok := intersect(value, dstValue); ok {
+					out[key] = v
+				}
+			}
+		}
+		return out, len(out) > 0 || len(src) == 0
+	case []any:
+		dst, ok := dst.([]any)
+		if !ok || len(dst) != len(src) {
+			return nil, false
+		}
+		out := make([]any, len(src))
+		for i := range src {
+			if v, ok := intersect(src[i], dst[i]); ok {
+				out[i] = v
+			} else {
+				out[i] = dst[i]
+			}
+		}
+		return out, true
+	default:
+		return src, reflect.DeepEqual(src, dst)
+	}
+}
+
+// toTree returns the JSON representation of in as maps, slices and scalars.
+func toTree(in any) any {
+	data, err := json.Marshal(in)
+	if err != nil {
+		panic(err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var out any
+	if err := decoder.Decode(&out); err != nil {
+		panic(err)
+	}
+	return out
+}
+
+// prune returns the parts of src that are missing from, or differ in, dst, and
+// whether there are any. The path of each is appended to missing.
+func prune(src, dst any, path string, missing *[]string) (any, bool) {
+	switch src := src.(type) {
+	case map[string]any:
+		dst, ok := dst.(map[string]any)
+		if !ok {
+			*missing = append(*missing, path)
+			return src, true
+		}
+		lost := map[string]any{}
+		for key, value := range src {
+			if value == nil {
+				continue
+			}
+			keyPath := strings.TrimPrefix(path+"."+key, ".")
+			dstValue, ok := dst[key]
+			if !ok {
+				*missing = append(*missing, keyPath)
+				lost[key] = value
+				continue
+			}
+			if l, ok := prune(value, dstValue, keyPath, missing); ok {
+				lost[key] = l
+			}
+		}
+		return lost, len(lost) > 0
+	case []any:
+		dst, ok := dst.([]any)
+		if !ok || len(dst) != len(src) {
+			*missing = append(*missing, path)
+			return src, true
+		}
+		lost := make([]any, len(src))
+		found := false
+		for i := range src {
+			itemPath := fmt.Sprintf("%s[%d]", path, i)
+			if l, ok := prune(src[i], dst[i], itemPath, missing); ok {
+				lost[i] = l
+				found = true
+			}
+		}
+		return lost, found
+	default:
+		if reflect.DeepEqual(src, dst) {
+			return nil, false
+		}
+		*missing = append(*missing, path)
+		return src, true
+	}
+}
+
+// merge returns base overlaid with overlay. Objects are merged by key and
+// arrays of equal length by index. Arrays of different lengths cannot be
+// matched up, so the overlay array is kept as a whole. Otherwise overlay wins
+// unless it is nil.
+func merge(base, overlay any) any {
+	switch overlay := overlay.(type) {
+	case nil:
+		return base
+	case map[string]any:
+		out := map[string]any{}
+		if base, ok := base.(map[string]any); ok {
+			for key, value := range base {
+				out[key] = value
+			}
+		}
+		for key, value := range overlay {
+			out[key] = merge(out[key], value)
+		}
+		return out
+	case []any:
+		base, ok := base.([]any)
+		if !ok || len(base) != len(overlay) {
+			base = make([]any, len(overlay))
+		}
+		out := make([]any, len(overlay))
+		for i := range overlay {
+			out[i] = merge(base[i], overlay[i])
+		}
+		return out
+	default:
+		return overlay
+	}
+}
@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+// fill sets every field reachable from v to a non-zero value.
+func fill(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		v.Set(reflect.New(v.Type().Elem()))
+		fill(v.Elem())
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				fill(v.Field(i))
+			}
+		}
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 2, 2))
+		for i := 0; i < v.Len(); i++ {
+			fill(v.Index(i))
+		}
+	case reflect.Map:
+		v.Set(reflect.MakeMap(v.Type()))
+		key := reflect.New(v.Type().Key()).Elem()
+		fill(key)
+		value := reflect.New(v.Type().Elem()).Elem()
+		fill(value)
+		v.SetMapIndex(key, value)
+	case reflect.Interface:
+		v.Set(reflect.ValueOf("value"))
+	case reflect.String:
+		v.SetString("value")
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(7)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(7)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(7.5)
+	}
+}
+
+// filled returns a T with every field set.
+func filled[T any]() *T {
+	out := new(T)
+	fill(reflect.ValueOf(out).Elem())
+	return out
+}
+
+// requireJSONEqual asserts that a and b have the same JSON representation.
+func requireJSONEqual(t *testing.T, a, b any) {
+	t.Helper()
+	aData, err := json.Marshal(a)
+	require.NoError(t, err)
+	bData, err := json.Marshal(b)
+	require.NoError(t, err)
+	require.JSONEq(t, string(aData), string(bData))
+}
+
+type convertA struct {
+	Name    *string    `json:"name,omitempty"`
+	Count   *int32     `json:"count,omitempty"`
+	Binding *int32     `json:"binding,omitempty"`
+	Items   []convItem `json:"items,omitempty"`
+}
+
+type convertB struct {
+	Name    *string     `json:"name,omitempty"`
+	Count   *int64      `json:"count,omitempty"`
+	Binding *[]string   `json:"binding,omitempty"`
+	Items   []convItem2 `json:"items,omitempty"`
+}
+
+type convItem struct {
+	Name  string `json:"name"`
+	Power *int32 `json:"power,omitempty"`
+}
+
+type convItem2 struct {
+	Name string `json:"name"`
+}
+
+func Test_convert(t *testing.T) {
+	a := convertA{
+		Name:    ptr.To("foo"),
+		Count:   ptr.To[int32](2),
+		Binding: ptr.To[int32](3),
+		Items: []convItem{
+			{Name: "bar"},
+			{Name: "baz", Power: ptr.To[int32](4)},
+		},
+	}
+
+	b := convertB{}
+	extra, missing := convert(a, nil, &b)
+	require.Equal(t, []string{"binding", "items[1].power"}, missing)
+	require.Equal(t, convertB{
+		Name:  ptr.To("foo"),
+		Count: ptr.To[int64](2),
+		Items: []convItem2{{Name: "bar"}, {Name: "baz"}},
+	}, b)
+
+	got := convertA{}
+	extra, missing = convert(b, extra, &got)
+	require.Nil(t, extra)
+	require.Empty(t, missing)
+	require.Equal(t, a, got)
+
+	// The fields of the hub win over stale extra fields, and arrays of a
+	// different length are kept.
+	b.Name = nil
+	b.Items = append(b.Items, convItem2{Name: "qux"})
+	got = convertA{}
+	_, missing = convert(b, Extra{"name": "stale", "binding": 3, "items": []any{nil, map[string]any{"power": 4}}}, &got)
+	require.Empty(t, missing)
+	require.Equal(t, convertA{
+		Count:   ptr.To[int32](2),
+		Binding: ptr.To[int32](3),
+		Items:   []convItem{{Name: "bar"}, {Name: "baz"}, {Name: "qux"}},
+	}, got)
+
+	b.Count = ptr.To[int64](1 << 40)
+	b.Binding = ptr.To([]string{"none"})
+	got = convertA{}
+	_, missing = convert(b, nil, &got)
+	require.Equal(t, []string{"binding", "count"}, missing)
+	require.Nil(t, got.Count)
+}
+
+func Test_merge(t *testing.T) {
+	base := map[string]any{
+		"a": "foo",
+		"b": map[string]any{"c": "bar"},
+		"d": []any{map[string]any{"e": "baz"}, "qux"},
+	}
+	overlay := map[string]any{
+		"b": map[string]any{"f": "bar"},
+		"d": []any{map[string]any{"g": "baz"}, nil},
+	}
+	want := map[string]any{
+		"a": "foo",
+		"b": map[string]any{"c": "bar", "f": "bar"},
+		"d": []any{map[string]any{"e": "baz", "g": "baz"}, "qux"},
+	}
+	require.Equal(t, want, merge(base, overlay))
+	require.Equal(t, base, merge(base, nil))
+
+	overlay = map[string]any{
+		"d": []any{map[string]any{"g": "baz"}},
+	}
+	want = map[string]any{
+		"a": "foo",
+		"b": map[string]any{"c": "bar"},
+		"d": []any{map[string]any{"g": "baz"}},
+	}
+	require.Equal(t, want, merge(base, overlay))
+}
+
+func TestExtra_DeepCopy(t *testing.T) {
+	extra := Extra{"power": map[string]any{"current_watts": json.Number("5")}}
+	out := extra.DeepCopy()
+	require.Equal(t, extra, out)
+	out["power"].(map[string]any)["current_watts"] = json.Number("6")
+	require.Equal(t, json.Number("5"), extra["power"].(map[string]any)["current_watts"])
+	require.Nil(t, Extra(nil).DeepCopy())
+}
@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+	"github.com/SlinkyProject/slurm-client/pkg/version"
+)
+
+// JobInfo is the version-agnostic representation of a Slurm job.
+type JobInfo struct {
+	api.V0045JobInfo
+	// Extra holds the fields of the source version that JobInfo cannot
+	// represent.
+	Extra Extra
+}
+
+// DeepCopy returns a copy of the job.
+func (o *JobInfo) DeepCopy() *JobInfo {
+	out := &JobInfo{Extra: o.Extra.DeepCopy()}
+	utils.RemarshalOrDie(o.V0045JobInfo, &out.V0045JobInfo)
+	return out
+}
+
+// JobInfoFrom converts any versioned job into a JobInfo.
+func JobInfoFrom(obj object.Object) (*JobInfo, error) {
+	switch o := obj.(type) {
+	case *types.V0042JobInfo:
+		return JobInfoFromV0042(o), nil
+	case *types.V0043JobInfo:
+		return JobInfoFromV0043(o), nil
+	case *types.V0044JobInfo:
+		return JobInfoFromV0044(o), nil
+	case *types.V0045JobInfo:
+		return JobInfoFromV0045(o), nil
+	default:
+		return nil, &UnknownTypeError{ObjectType: obj.GetType()}
+	}
+}
+
+// JobInfoFromV0042 converts a V0042JobInfo into a JobInfo.
+func JobInfoFromV0042(in *types.V0042JobInfo) *JobInfo {
+	out := &JobInfo{}
+	out.Extra, _ = convert(in.V0042JobInfo, nil, &out.V0045JobInfo)
+	return out
+}
+
+// JobInfoFromV0043 converts a V0043JobInfo into a JobInfo.
+func JobInfoFromV0043(in *types.V0043JobInfo) *JobInfo {
+	out := &JobInfo{}
+	out.Extra, _ = convert(in.V0043JobInfo, nil, &out.V0045JobInfo)
+	return out
+}
+
+// JobInfoFromV0044 converts a V0044JobInfo into a JobInfo.
+func JobInfoFromV0044(in *types.V0044JobInfo) *JobInfo {
+	out := &JobInfo{}
+	out.Extra, _ = convert(in.V0044JobInfo, nil, &out.V0045JobInfo)
+	return out
+}
+
+// JobInfoFromV0045 converts a V0045JobInfo into a JobInfo.
+func JobInfoFromV0045(in *types.V0045JobInfo) *JobInfo {
+	out := &JobInfo{}
+	out.Extra, _ = convert(in.V0045JobInfo, nil, &out.V0045JobInfo)
+	return out
+}
+
+// To converts the job into the versioned job of apiVersion (e.g. "v0.0.45"). It
+// returns the fields that apiVersion cannot represent.
+func (o *JobInfo) To(apiVersion string) (object.Object, []string, error) {
+	switch apiVersion {
+	case version.V0042:
+		out, missing := o.ToV0042()
+		return out, missing, nil
+	case version.V0043:
+		out, missing := o.ToV0043()
+		return out, missing, nil
+	case version.V0044:
+		out, missing := o.ToV0044()
+		return out, missing, nil
+	case version.V0045:
+		out, missing := o.ToV0045()
+		return out, missing, nil
+	default:
+		return nil, nil, &UnknownVersionError{Version: apiVersion}
+	}
+}
+
+// ToV0042 converts the job into a V0042JobInfo. It returns the fields
+// V0042JobInfo cannot represent.
+func (o *JobInfo) ToV0042() (*types.V0042JobInfo, []string) {
+	out := &types.V0042JobInfo{}
+	_, missing := convert(o.V0045JobInfo, o.Extra, &out.V0042JobInfo)
+	return out, missing
+}
+
+// ToV0043 converts the job into a V0043JobInfo. It returns the fields
+// V0043JobInfo cannot represent.
+func (o *JobInfo) ToV0043() (*types.V0043JobInfo, []string) {
+	out := &types.V0043JobInfo{}
+	_, missing := convert(o.V0045JobInfo, o.Extra, &out.V0043JobInfo)
+	return out, missing
+}
+
+// ToV0044 converts the job into a V0044JobInfo. It returns the fields
+// V0044JobInfo cannot represent.
+func (o *JobInfo) ToV0044() (*types.V0044JobInfo, []string) {
+	out := &types.V0044JobInfo{}
+	_, missing := convert(o.V0045JobInfo, o.Extra, &out.V0044JobInfo)
+	return out, missing
+}
+
+// ToV0045 converts the job into a V0045JobInfo. It returns the fields
+// V0045JobInfo cannot represent.
+func (o *JobInfo) ToV0045() (*types.V0045JobInfo, []string) {
+	out := &types.V0045JobInfo{}
+	_, missing := convert(o.V0045JobInfo, o.Extra, &out.V0045JobInfo)
+	return out, missing
+}
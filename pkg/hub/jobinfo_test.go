@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+	"github.com/SlinkyProject/slurm-client/pkg/version"
+)
+
+func TestJobInfo_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      object.Object
+		version string
+	}{
+		{
+			name:    "v0042",
+			in:      filled[types.V0042JobInfo](),
+			version: version.V0042,
+		},
+		{
+			name:    "v0043",
+			in:      filled[types.V0043JobInfo](),
+			version: version.V0043,
+		},
+		{
+			name:    "v0044",
+			in:      filled[types.V0044JobInfo](),
+			version: version.V0044,
+		},
+		{
+			name:    "v0045",
+			in:      filled[types.V0045JobInfo](),
+			version: version.V0045,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hub, err := JobInfoFrom(tt.in)
+			require.NoError(t, err)
+			out, missing, err := hub.DeepCopy().To(tt.version)
+			require.NoError(t, err)
+			require.Empty(t, missing)
+			require.Equal(t, tt.in.GetType(), out.GetType())
+			requireJSONEqual(t, tt.in, out)
+		})
+	}
+}
+
+func TestJobInfo_Missing(t *testing.T) {
+	hub := JobInfoFromV0042(filled[types.V0042JobInfo]())
+	out, missing := hub.ToV0045()
+	require.Equal(t, []string{"power"}, missing)
+	require.Equal(t, int32(7), *out.JobId)
+
+	hub = JobInfoFromV0045(filled[types.V0045JobInfo]())
+	_, missing = hub.ToV0042()
+	require.Equal(t, []string{"container_type", "licenses_allocated", "memory_update_delay", "memory_update_margin", "segment_size", "stderr_expanded", "stdin_expanded", "stdout_expanded", "step_id", "submit_line"}, missing)
+}
+
+func TestJobInfoFrom(t *testing.T) {
+	_, err := JobInfoFrom(&types.V0045Node{})
+	require.Error(t, err)
+
+	_, _, err = JobInfoFromV0045(&types.V0045JobInfo{}).To("v0.0.41")
+	require.Error(t, err)
+}
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+	"github.com/SlinkyProject/slurm-client/pkg/version"
+)
+
+// Node is the version-agnostic representation of a Slurm node.
+type Node struct {
+	api.V0045Node
+	// Extra holds the fields of the source version that Node cannot represent.
+	Extra Extra
+}
+
+// DeepCopy returns a copy of the node.
+func (o *Node) DeepCopy() *Node {
+	out := &Node{Extra: o.Extra.DeepCopy()}
+	utils.RemarshalOrDie(o.V0045Node, &out.V0045Node)
+	return out
+}
+
+// NodeFrom converts any versioned node into a Node.
+func NodeFrom(obj object.Object) (*Node, error) {
+	switch o := obj.(type) {
+	case *types.V0042Node:
+		return NodeFromV0042(o), nil
+	case *types.V0043Node:
+		return NodeFromV0043(o), nil
+	case *types.V0044Node:
+		return NodeFromV0044(o), nil
+	case *types.V0045Node:
+		return NodeFromV0045(o), nil
+	default:
+		return nil, &UnknownTypeError{ObjectType: obj.GetType()}
+	}
+}
+
+// NodeFromV0042 converts a V0042Node into a Node.
+func NodeFromV0042(in *types.V0042Node) *Node {
+	out := &Node{}
+	out.Extra, _ = convert(in.V0042Node, nil, &out.V0045Node)
+	return out
+}
+
+// NodeFromV0043 converts a V0043Node into a Node.
+func NodeFromV0043(in *types.V0043Node) *Node {
+	out := &Node{}
+	out.Extra, _ = convert(in.V0043Node, nil, &out.V0045Node)
+	return out
+}
+
+// NodeFromV0044 converts a V0044Node into a Node.
+func NodeFromV0044(in *types.V0044Node) *Node {
+	out := &Node{}
+	out.Extra, _ = convert(in.V0044Node, nil, &out.V0045Node)
+	return out
+}
+
+// NodeFromV0045 converts a V0045Node into a Node.
+func NodeFromV0045(in *types.V0045Node) *Node {
+	out := &Node{}
+	out.Extra, _ = convert(in.V0045Node, nil, &out.V0045Node)
+	return out
+}
+
+// To converts the node into the versioned node of apiVersion (e.g. "v0.0.45").
+// It returns the fields that apiVersion cannot represent.
+func (o *Node) To(apiVersion string) (object.Object, []string, error) {
+	switch apiVersion {
+	case version.V0042:
+		out, missing := o.ToV0042()
+		return out, missing, nil
+	case version.V0043:
+		out, missing := o.ToV0043()
+		return out, missing, nil
+	case version.V0044:
+		out, missing := o.ToV0044()
+		return out, missing, nil
+	case version.V0045:
+		out, missing := o.ToV0045()
+		return out, missing, nil
+	default:
+		return nil, nil, &UnknownVersionError{Version: apiVersion}
+	}
+}
+
+// ToV0042 converts the node into a V0042Node. It returns the fields V0042Node
+// cannot represent.
+func (o *Node) ToV0042() (*types.V0042Node, []string) {
+	out := &types.V0042Node{}
+	_, missing := convert(o.V0045Node, o.Extra, &out.V0042Node)
+	return out, missing
+}
+
+// ToV0043 converts the node into a V0043Node. It returns the fields V0043Node
+// cannot represent.
+func (o *Node) ToV0043() (*types.V0043Node, []string) {
+	out := &types.V0043Node{}
+	_, missing := convert(o.V0045Node, o.Extra, &out.V0043Node)
+	return out, missing
+}
+
+// ToV0044 converts the node into a V0044Node. It returns the fields V0044Node
+// cannot represent.
+func (o *Node) ToV0044() (*types.V0044Node, []string) {
+	out := &types.V0044Node{}
+	_, missing := convert(o.V0045Node, o.Extra, &out.V0044Node)
+	return out, missing
+}
+
+// ToV0045 converts the node into a V0045Node. It returns the fields V0045Node
+// cannot represent.
+func (o *Node) ToV0045() (*types.V0045Node, []string) {
+	out := &types.V0045Node{}
+	_, missing := convert(o.V0045Node, o.Extra, &out.V0045Node)
+	return out, missing
+}
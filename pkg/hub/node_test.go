@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+	"github.com/SlinkyProject/slurm-client/pkg/version"
+)
+
+func TestNode_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      object.Object
+		version string
+	}{
+		{
+			name:    "v0042",
+			in:      filled[types.V0042Node](),
+			version: version.V0042,
+		},
+		{
+			name:    "v0043",
+			in:      filled[types.V0043Node](),
+			version: version.V0043,
+		},
+		{
+			name:    "v0044",
+			in:      filled[types.V0044Node](),
+			version: version.V0044,
+		},
+		{
+			name:    "v0045",
+			in:      filled[types.V0045Node](),
+			version: version.V0045,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hub, err := NodeFrom(tt.in)
+			require.NoError(t, err)
+			out, missing, err := hub.DeepCopy().To(tt.version)
+			require.NoError(t, err)
+			require.Empty(t, missing)
+			require.Equal(t, tt.in.GetType(), out.GetType())
+			requireJSONEqual(t, tt.in, out)
+		})
+	}
+}
+
+func TestNode_Missing(t *testing.T) {
+	hub := NodeFromV0042(filled[types.V0042Node]())
+	out, missing := hub.ToV0045()
+	require.Equal(t, []string{"external_sensors", "power", "tres_weighted"}, missing)
+	require.Equal(t, "value", *out.Name)
+
+	hub = NodeFromV0045(filled[types.V0045Node]())
+	_, missing = hub.ToV0042()
+	require.NotEmpty(t, missing)
+	require.Contains(t, missing, "cert_flags")
+	require.Contains(t, missing, "tls_cert_last_renewal")
+}
+
+func TestNodeFrom(t *testing.T) {
+	_, err := NodeFrom(&types.V0045JobInfo{})
+	require.Error(t, err)
+
+	_, _, err = NodeFromV0045(&types.V0045Node{}).To("v0.0.41")
+	require.Error(t, err)
+}
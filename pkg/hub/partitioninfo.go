@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+	"github.com/SlinkyProject/slurm-client/pkg/version"
+)
+
+// PartitionInfo is the version-agnostic representation of a Slurm partition.
+type PartitionInfo struct {
+	api.V0045PartitionInfo
+	// Extra holds the fields of the source version that PartitionInfo cannot
+	// represent.
+	Extra Extra
+}
+
+// DeepCopy returns a copy of the partition.
+func (o *PartitionInfo) DeepCopy() *PartitionInfo {
+	out := &PartitionInfo{Extra: o.Extra.DeepCopy()}
+	utils.RemarshalOrDie(o.V0045PartitionInfo, &out.V0045PartitionInfo)
+	return out
+}
+
+// PartitionInfoFrom converts any versioned partition into a PartitionInfo.
+func PartitionInfoFrom(obj object.Object) (*PartitionInfo, error) {
+	switch o := obj.(type) {
+	case *types.V0042PartitionInfo:
+		return PartitionInfoFromV0042(o), nil
+	case *types.V0043PartitionInfo:
+		return PartitionInfoFromV0043(o), nil
+	case *types.V0044PartitionInfo:
+		return PartitionInfoFromV0044(o), nil
+	case *types.V0045PartitionInfo:
+		return PartitionInfoFromV0045(o), nil
+	default:
+		return nil, &UnknownTypeError{ObjectType: obj.GetType()}
+	}
+}
+
+// PartitionInfoFromV0042 converts a V0042PartitionInfo into a PartitionInfo.
+func PartitionInfoFromV0042(in *types.V0042PartitionInfo) *PartitionInfo {
+	out := &PartitionInfo{}
+	out.Extra, _ = convert(in.V0042PartitionInfo, nil, &out.V0045PartitionInfo)
+	return out
+}
+
+// PartitionInfoFromV0043 converts a V0043PartitionInfo into a PartitionInfo.
+func PartitionInfoFromV0043(in *types.V0043PartitionInfo) *PartitionInfo {
+	out := &PartitionInfo{}
+	out.Extra, _ = convert(in.V0043PartitionInfo, nil, &out.V0045PartitionInfo)
+	return out
+}
+
+// PartitionInfoFromV0044 converts a V0044PartitionInfo into a PartitionInfo.
+func PartitionInfoFromV0044(in *types.V0044PartitionInfo) *PartitionInfo {
+	out := &PartitionInfo{}
+	out.Extra, _ = convert(in.V0044PartitionInfo, nil, &out.V0045PartitionInfo)
+	return out
+}
+
+// PartitionInfoFromV0045 converts a V0045PartitionInfo into a PartitionInfo.
+func PartitionInfoFromV0045(in *types.V0045PartitionInfo) *PartitionInfo {
+	out := &PartitionInfo{}
+	out.Extra, _ = convert(in.V0045PartitionInfo, nil, &out.V0045PartitionInfo)
+	return out
+}
+
+// To converts the partition into the versioned partition of apiVersion (e.g.
+// "v0.0.45"). It returns the fields that apiVersion cannot represent.
+func (o *PartitionInfo) To(apiVersion string) (object.Object, []string, error) {
+	switch apiVersion {
+	case version.V0042:
+		out, missing := o.ToV0042()
+		return out, missing, nil
+	case version.V0043:
+		out, missing := o.ToV0043()
+		return out, missing, nil
+	case version.V0044:
+		out, missing := o.ToV0044()
+		return out, missing, nil
+	case version.V0045:
+		out, missing := o.ToV0045()
+		return out, missing, nil
+	default:
+		return nil, nil, &UnknownVersionError{Version: apiVersion}
+	}
+}
+
+// ToV0042 converts the partition into a V0042PartitionInfo. It returns the
+// fields V0042PartitionInfo cannot represent.
+func (o *PartitionInfo) ToV0042() (*types.V0042PartitionInfo, []string) {
+	out := &types.V0042PartitionInfo{}
+	_, missing := convert(o.V0045PartitionInfo, o.Extra, &out.V0042PartitionInfo)
+	return out, missing
+}
+
+// ToV0043 converts the partition into a V0043PartitionInfo. It returns the
+// fields V0043PartitionInfo cannot represent.
+func (o *PartitionInfo) ToV0043() (*types.V0043PartitionInfo, []string) {
+	out := &types.V0043PartitionInfo{}
+	_, missing := convert(o.V0045PartitionInfo, o.Extra, &out.V0043PartitionInfo)
+	return out, missing
+}
+
+// ToV0044 converts the partition into a V0044PartitionInfo. It returns the
+// fields V0044PartitionInfo cannot represent.
+func (o *PartitionInfo) ToV0044() (*types.V0044PartitionInfo, []string) {
+	out := &types.V0044PartitionInfo{}
+	_, missing := convert(o.V0045PartitionInfo, o.Extra, &out.V0044PartitionInfo)
+	return out, missing
+}
+
+// ToV0045 converts the partition into a V0045PartitionInfo. It returns the
+// fields V0045PartitionInfo cannot represent.
+func (o *PartitionInfo) ToV0045() (*types.V0045PartitionInfo, []string) {
+	out := &types.V0045PartitionInfo{}
+	_, missing := convert(o.V0045PartitionInfo, o.Extra, &out.V0045PartitionInfo)
+	return out, missing
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+	"github.com/SlinkyProject/slurm-client/pkg/version"
+)
+
+func TestPartitionInfo_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      object.Object
+		version string
+	}{
+		{
+			name:    "v0042",
+			in:      filled[types.V0042PartitionInfo](),
+			version: version.V0042,
+		},
+		{
+			name:    "v0043",
+			in:      filled[types.V0043PartitionInfo](),
+			version: version.V0043,
+		},
+		{
+			name:    "v0044",
+			in:      filled[types.V0044PartitionInfo](),
+			version: version.V0044,
+		},
+		{
+			name:    "v0045",
+			in:      filled[types.V0045PartitionInfo](),
+			version: version.V0045,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hub, err := PartitionInfoFrom(tt.in)
+			require.NoError(t, err)
+			out, missing, err := hub.DeepCopy().To(tt.version)
+			require.NoError(t, err)
+			require.Empty(t, missing)
+			require.Equal(t, tt.in.GetType(), out.GetType())
+			requireJSONEqual(t, tt.in, out)
+		})
+	}
+}
+
+func TestPartitionInfo_Missing(t *testing.T) {
+	hub := PartitionInfoFromV0042(filled[types.V0042PartitionInfo]())
+	out, missing := hub.ToV0045()
+	require.Equal(t, []string{"cpus.task_binding"}, missing)
+	require.Equal(t, "value", *out.Name)
+
+	hub = PartitionInfoFromV0045(filled[types.V0045PartitionInfo]())
+	_, missing = hub.ToV0042()
+	require.Equal(t, []string{"cpus.task_binding", "flags", "partition.exclusive", "partition.oversubscribe", "preempt_mode", "topology"}, missing)
+}
+
+func TestPartitionInfoFrom(t *testing.T) {
+	_, err := PartitionInfoFrom(&types.V0045Node{})
+	require.Error(t, err)
+
+	_, _, err = PartitionInfoFromV0045(&types.V0045PartitionInfo{}).To("v0.0.41")
+	require.Error(t, err)
+}
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+	"github.com/SlinkyProject/slurm-client/pkg/version"
+)
+
+// ReservationInfo is the version-agnostic representation of a Slurm
+// reservation.
+type ReservationInfo struct {
+	api.V0045ReservationInfo
+	// Extra holds the fields of the source version that ReservationInfo cannot
+	// represent.
+	Extra Extra
+}
+
+// DeepCopy returns a copy of the reservation.
+func (o *ReservationInfo) DeepCopy() *ReservationInfo {
+	out := &ReservationInfo{Extra: o.Extra.DeepCopy()}
+	utils.RemarshalOrDie(o.V0045ReservationInfo, &out.V0045ReservationInfo)
+	return out
+}
+
+// ReservationInfoFrom converts any versioned reservation into a
+// ReservationInfo.
+func ReservationInfoFrom(obj object.Object) (*ReservationInfo, error) {
+	switch o := obj.(type) {
+	case *types.V0044ReservationInfo:
+		return ReservationInfoFromV0044(o), nil
+	case *types.V0045ReservationInfo:
+		return ReservationInfoFromV0045(o), nil
+	default:
+		return nil, &UnknownTypeError{ObjectType: obj.GetType()}
+	}
+}
+
+// ReservationInfoFromV0044 converts a V0044ReservationInfo into a
+// ReservationInfo.
+func ReservationInfoFromV0044(in *types.V0044ReservationInfo) *ReservationInfo {
+	out := &ReservationInfo{}
+	out.Extra, _ = convert(in.V0044ReservationInfo, nil, &out.V0045ReservationInfo)
+	return out
+}
+
+// ReservationInfoFromV0045 converts a V0045ReservationInfo into a
+// ReservationInfo.
+func ReservationInfoFromV0045(in *types.V0045ReservationInfo) *ReservationInfo {
+	out := &ReservationInfo{}
+	out.Extra, _ = convert(in.V0045ReservationInfo, nil, &out.V0045ReservationInfo)
+	return out
+}
+
+// To converts the reservation into the versioned reservation of apiVersion
+// (e.g. "v0.0.45"). It returns the fields that apiVersion cannot represent.
+func (o *ReservationInfo) To(apiVersion string) (object.Object, []string, error) {
+	switch apiVersion {
+	case version.V0044:
+		out, missing := o.ToV0044()
+		return out, missing, nil
+	case version.V0045:
+		out, missing := o.ToV0045()
+		return out, missing, nil
+	default:
+		return nil, nil, &UnknownVersionError{Version: apiVersion}
+	}
+}
+
+// ToV0044 converts the reservation into a V0044ReservationInfo. It returns the
+// fields V0044ReservationInfo cannot represent.
+func (o *ReservationInfo) ToV0044() (*types.V0044ReservationInfo, []string) {
+	out := &types.V0044ReservationInfo{}
+	_, missing := convert(o.V0045ReservationInfo, o.Extra, &out.V0044ReservationInfo)
+	return out, missing
+}
+
+// ToV0045 converts the reservation into a V0045ReservationInfo. It returns the
+// fields V0045ReservationInfo cannot represent.
+func (o *ReservationInfo) ToV0045() (*types.V0045ReservationInfo, []string) {
+	out := &types.V0045ReservationInfo{}
+	_, missing := convert(o.V0045ReservationInfo, o.Extra, &out.V0045ReservationInfo)
+	return out, missing
+}
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+	"github.com/SlinkyProject/slurm-client/pkg/version"
+)
+
+func TestReservationInfo_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      object.Object
+		version string
+	}{
+		{
+			name:    "v0044",
+			in:      filled[types.V0044ReservationInfo](),
+			version: version.V0044,
+		},
+		{
+			name:    "v0045",
+			in:      filled[types.V0045ReservationInfo](),
+			version: version.V0045,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hub, err := ReservationInfoFrom(tt.in)
+			require.NoError(t, err)
+			out, missing, err := hub.DeepCopy().To(tt.version)
+			require.NoError(t, err)
+			require.Empty(t, missing)
+			require.Equal(t, tt.in.GetType(), out.GetType())
+			requireJSONEqual(t, tt.in, out)
+		})
+	}
+}
+
+func TestReservationInfo_Missing(t *testing.T) {
+	hub := ReservationInfoFromV0044(filled[types.V0044ReservationInfo]())
+	out, missing := hub.ToV0045()
+	require.Equal(t, []string{"watts"}, missing)
+	require.Equal(t, "value", *out.Name)
+
+	hub = ReservationInfoFromV0045(filled[types.V0045ReservationInfo]())
+	_, missing = hub.ToV0044()
+	require.Empty(t, missing)
+}
+
+func TestReservationInfoFrom(t *testing.T) {
+	_, err := ReservationInfoFrom(&types.V0045Node{})
+	require.Error(t, err)
+
+	_, _, err = ReservationInfoFromV0045(&types.V0045ReservationInfo{}).To("v0.0.41")
+	require.Error(t, err)
+}
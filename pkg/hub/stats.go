@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+	"github.com/SlinkyProject/slurm-client/pkg/version"
+)
+
+// Stats is the version-agnostic representation of the slurmctld statistics.
+type Stats struct {
+	api.V0045StatsMsg
+	// Extra holds the fields of the source version that Stats cannot represent.
+	Extra Extra
+}
+
+// DeepCopy returns a copy of the statistics.
+func (o *Stats) DeepCopy() *Stats {
+	out := &Stats{Extra: o.Extra.DeepCopy()}
+	utils.RemarshalOrDie(o.V0045StatsMsg, &out.V0045StatsMsg)
+	return out
+}
+
+// StatsFrom converts any versioned statistics into a Stats.
+func StatsFrom(obj object.Object) (*Stats, error) {
+	switch o := obj.(type) {
+	case *types.V0042Stats:
+		return StatsFromV0042(o), nil
+	case *types.V0043Stats:
+		return StatsFromV0043(o), nil
+	case *types.V0044Stats:
+		return StatsFromV0044(o), nil
+	case *types.V0045Stats:
+		return StatsFromV0045(o), nil
+	default:
+		return nil, &UnknownTypeError{ObjectType: obj.GetType()}
+	}
+}
+
+// StatsFromV0042 converts a V0042Stats into a Stats.
+func StatsFromV0042(in *types.V0042Stats) *Stats {
+	out := &Stats{}
+	out.Extra, _ = convert(in.V0042StatsMsg, nil, &out.V0045StatsMsg)
+	return out
+}
+
+// StatsFromV0043 converts a V0043Stats into a Stats.
+func StatsFromV0043(in *types.V0043Stats) *Stats {
+	out := &Stats{}
+	out.Extra, _ = convert(in.V0043StatsMsg, nil, &out.V0045StatsMsg)
+	return out
+}
+
+// StatsFromV0044 converts a V0044Stats into a Stats.
+func StatsFromV0044(in *types.V0044Stats) *Stats {
+	out := &Stats{}
+	out.Extra, _ = convert(in.V0044StatsMsg, nil, &out.V0045StatsMsg)
+	return out
+}
+
+// StatsFromV0045 converts a V0045Stats into a Stats.
+func StatsFromV0045(in *types.V0045Stats) *Stats {
+	out := &Stats{}
+	out.Extra, _ = convert(in.V0045StatsMsg, nil, &out.V0045StatsMsg)
+	return out
+}
+
+// To converts the statistics into the versioned statistics of apiVersion (e.g.
+// "v0.0.45"). It returns the fields that apiVersion cannot represent.
+func (o *Stats) To(apiVersion string) (object.Object, []string, error) {
+	switch apiVersion {
+	case version.V0042:
+		out, missing := o.ToV0042()
+		return out, missing, nil
+	case version.V0043:
+		out, missing := o.ToV0043()
+		return out, missing, nil
+	case version.V0044:
+		out, missing := o.ToV0044()
+		return out, missing, nil
+	case version.V0045:
+		out, missing := o.ToV0045()
+		return out, missing, nil
+	default:
+		return nil, nil, &UnknownVersionError{Version: apiVersion}
+	}
+}
+
+// ToV0042 converts the statistics into a V0042Stats. It returns the fields
+// V0042Stats cannot represent.
+func (o *Stats) ToV0042() (*types.V0042Stats, []string) {
+	out := &types.V0042Stats{}
+	_, missing := convert(o.V0045StatsMsg, o.Extra, &out.V0042StatsMsg)
+	return out, missing
+}
+
+// ToV0043 converts the statistics into a V0043Stats. It returns the fields
+// V0043Stats cannot represent.
+func (o *Stats) ToV0043() (*types.V0043Stats, []string) {
+	out := &types.V0043Stats{}
+	_, missing := convert(o.V0045StatsMsg, o.Extra, &out.V0043StatsMsg)
+	return out, missing
+}
+
+// ToV0044 converts the statistics into a V0044Stats. It returns the fields
+// V0044Stats cannot represent.
+func (o *Stats) ToV0044() (*types.V0044Stats, []string) {
+	out := &types.V0044Stats{}
+	_, missing := convert(o.V0045StatsMsg, o.Extra, &out.V0044StatsMsg)
+	return out, missing
+}
+
+// ToV0045 converts the statistics into a V0045Stats. It returns the fields
+// V0045Stats cannot represent.
+func (o *Stats) ToV0045() (*types.V0045Stats, []string) {
+	out := &types.V0045Stats{}
+	_, missing := convert(o.V0045StatsMsg, o.Extra, &out.V0045StatsMsg)
+	return out, missing
+}
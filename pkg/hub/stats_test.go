@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/types"
+	"github.com/SlinkyProject/slurm-client/pkg/version"
+)
+
+func TestStats_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      object.Object
+		version string
+	}{
+		{
+			name:    "v0042",
+			in:      filled[types.V0042Stats](),
+			version: version.V0042,
+		},
+		{
+			name:    "v0043",
+			in:      filled[types.V0043Stats](),
+			version: version.V0043,
+		},
+		{
+			name:    "v0044",
+			in:      filled[types.V0044Stats](),
+			version: version.V0044,
+		},
+		{
+			name:    "v0045",
+			in:      filled[types.V0045Stats](),
+			version: version.V0045,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hub, err := StatsFrom(tt.in)
+			require.NoError(t, err)
+			out, missing, err := hub.DeepCopy().To(tt.version)
+			require.NoError(t, err)
+			require.Empty(t, missing)
+			require.Equal(t, tt.in.GetType(), out.GetType())
+			requireJSONEqual(t, tt.in, out)
+		})
+	}
+}
+
+func TestStats_Missing(t *testing.T) {
+	hub := StatsFromV0042(filled[types.V0042Stats]())
+	out, missing := hub.ToV0045()
+	require.Equal(t, []string{"parts_packed"}, missing)
+	require.Equal(t, int64(7), *out.ScheduleCycleSum)
+
+	hub = StatsFromV0045(filled[types.V0045Stats]())
+	_, missing = hub.ToV0042()
+	require.Empty(t, missing)
+}
+
+func TestStatsFrom(t *testing.T) {
+	_, err := StatsFrom(&types.V0045Node{})
+	require.Error(t, err)
+
+	_, _, err = StatsFromV0045(&types.V0045Stats{}).To("v0.0.41")
+	require.Error(t, err)
+}
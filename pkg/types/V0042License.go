@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0042"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+const (
+	ObjectTypeV0042License = "V0042License"
+)
+
+type V0042License struct {
+	api.V0042License
+}
+
+// GetKey implements Object.
+func (o *V0042License) GetKey() object.ObjectKey {
+	return object.ObjectKey(ptr.Deref(o.LicenseName, ""))
+}
+
+// GetType implements Object.
+func (o *V0042License) GetType() object.ObjectType {
+	return ObjectTypeV0042License
+}
+
+// DeepCopyObject implements Object.
+func (o *V0042License) DeepCopyObject() object.Object {
+	return o.DeepCopy()
+}
+
+func (o *V0042License) DeepCopy() *V0042License {
+	out := new(V0042License)
+	utils.RemarshalOrDie(o, out)
+	return out
+}
+
+type V0042LicenseList struct {
+	Items []V0042License
+}
+
+// GetType implements ObjectList.
+func (o *V0042LicenseList) GetType() object.ObjectType {
+	return ObjectTypeV0042License
+}
+
+// GetItems implements ObjectList.
+func (o *V0042LicenseList) GetItems() []object.Object {
+	list := make([]object.Object, len(o.Items))
+	for i, item := range o.Items {
+		list[i] = item.DeepCopyObject()
+	}
+	return list
+}
+
+// AppendItem implements ObjectList.
+func (o *V0042LicenseList) AppendItem(object object.Object) {
+	out, ok := object.(*V0042License)
+	if ok {
+		utils.RemarshalOrDie(object, out)
+		o.Items = append(o.Items, *out)
+	}
+}
+
+// DeepCopyObjectList implements ObjectList.
+func (o *V0042LicenseList) DeepCopyObjectList() object.ObjectList {
+	out := new(V0042LicenseList)
+	out.Items = make([]V0042License, len(o.Items))
+	for i, item := range o.Items {
+		out.Items[i] = *item.DeepCopy()
+	}
+	return out
+}
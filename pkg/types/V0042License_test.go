@@ -0,0 +1,324 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0042"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+)
+
+func TestV0042License_GetKey(t *testing.T) {
+	type fields struct {
+		V0042License api.V0042License
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectKey
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0042License: api.V0042License{},
+			},
+			want: "",
+		},
+		{
+			name: "key",
+			fields: fields{
+				V0042License: api.V0042License{LicenseName: ptr.To("test_0")},
+			},
+			want: "test_0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0042License{
+				V0042License: tt.fields.V0042License,
+			}
+			got := o.GetKey()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0042License_GetType(t *testing.T) {
+	type fields struct {
+		V0042License api.V0042License
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				V0042License: api.V0042License{},
+			},
+			want: ObjectTypeV0042License,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0042License{
+				V0042License: tt.fields.V0042License,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0042License_DeepCopyObject(t *testing.T) {
+	type fields struct {
+		V0042License api.V0042License
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0042License: api.V0042License{},
+			},
+			want: &V0042License{},
+		},
+		{
+			name: "id",
+			fields: fields{
+				V0042License: api.V0042License{LicenseName: ptr.To("test_0")},
+			},
+			want: &V0042License{api.V0042License{LicenseName: ptr.To("test_0")}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0042License{
+				V0042License: tt.fields.V0042License,
+			}
+			got := o.DeepCopyObject()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0042License_DeepCopy(t *testing.T) {
+	type fields struct {
+		V0042License api.V0042License
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   *V0042License
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0042License: api.V0042License{},
+			},
+			want: &V0042License{},
+		},
+		{
+			name: "id",
+			fields: fields{
+				V0042License: api.V0042License{LicenseName: ptr.To("test_0")},
+			},
+			want: &V0042License{api.V0042License{LicenseName: ptr.To("test_0")}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0042License{
+				V0042License: tt.fields.V0042License,
+			}
+			got := o.DeepCopy()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0042LicenseList_GetType(t *testing.T) {
+	type fields struct {
+		Items []V0042License
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				Items: []V0042License{},
+			},
+			want: ObjectTypeV0042License,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0042LicenseList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0042LicenseList_GetItems(t *testing.T) {
+	type fields struct {
+		Items []V0042License
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   []object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0042License{},
+			},
+			want: []object.Object{},
+		},
+		{
+			name: "items",
+			fields: fields{
+				Items: []V0042License{
+					{V0042License: api.V0042License{LicenseName: ptr.To("test_0")}},
+					{V0042License: api.V0042License{LicenseName: ptr.To("test_1")}},
+				},
+			},
+			want: []object.Object{
+				&V0042License{api.V0042License{LicenseName: ptr.To("test_0")}},
+				&V0042License{api.V0042License{LicenseName: ptr.To("test_1")}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0042LicenseList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetItems()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0042LicenseList_AppendItem(t *testing.T) {
+	type fields struct {
+		Items []V0042License
+	}
+	type args struct {
+		object object.Object
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		args       args
+		wantAppend bool
+	}{
+		{
+			name: "nil",
+			fields: fields{
+				Items: []V0042License{},
+			},
+			args: args{
+				object: nil,
+			},
+			wantAppend: false,
+		},
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0042License{},
+			},
+			args: args{
+				object: &V0042License{},
+			},
+			wantAppend: true,
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0042License{
+					{V0042License: api.V0042License{LicenseName: ptr.To("test_0")}},
+					{V0042License: api.V0042License{LicenseName: ptr.To("test_1")}},
+				},
+			},
+			args: args{
+				object: &V0042License{},
+			},
+			wantAppend: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0042LicenseList{
+				Items: tt.fields.Items,
+			}
+			want := len(o.GetItems())
+			if tt.wantAppend {
+				want++
+			}
+			o.AppendItem(tt.args.object)
+			got := len(o.GetItems())
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func TestV0042LicenseList_DeepCopyObjectList(t *testing.T) {
+	type fields struct {
+		Items []V0042License
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectList
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0042License{},
+			},
+			want: &V0042LicenseList{
+				Items: []V0042License{},
+			},
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0042License{
+					{V0042License: api.V0042License{LicenseName: ptr.To("test_0")}},
+					{V0042License: api.V0042License{LicenseName: ptr.To("test_1")}},
+				},
+			},
+			want: &V0042LicenseList{
+				Items: []V0042License{
+					{api.V0042License{LicenseName: ptr.To("test_0")}},
+					{api.V0042License{LicenseName: ptr.To("test_1")}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0042LicenseList{
+				Items: tt.fields.Items,
+			}
+			got := o.DeepCopyObjectList()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
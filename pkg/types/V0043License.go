@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0043"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+const (
+	ObjectTypeV0043License = "V0043License"
+)
+
+type V0043License struct {
+	api.V0043License
+}
+
+// GetKey implements Object.
+func (o *V0043License) GetKey() object.ObjectKey {
+	return object.ObjectKey(ptr.Deref(o.LicenseName, ""))
+}
+
+// GetType implements Object.
+func (o *V0043License) GetType() object.ObjectType {
+	return ObjectTypeV0043License
+}
+
+// DeepCopyObject implements Object.
+func (o *V0043License) DeepCopyObject() object.Object {
+	return o.DeepCopy()
+}
+
+func (o *V0043License) DeepCopy() *V0043License {
+	out := new(V0043License)
+	utils.RemarshalOrDie(o, out)
+	return out
+}
+
+type V0043LicenseList struct {
+	Items []V0043License
+}
+
+// GetType implements ObjectList.
+func (o *V0043LicenseList) GetType() object.ObjectType {
+	return ObjectTypeV0043License
+}
+
+// GetItems implements ObjectList.
+func (o *V0043LicenseList) GetItems() []object.Object {
+	list := make([]object.Object, len(o.Items))
+	for i, item := range o.Items {
+		list[i] = item.DeepCopyObject()
+	}
+	return list
+}
+
+// AppendItem implements ObjectList.
+func (o *V0043LicenseList) AppendItem(object object.Object) {
+	out, ok := object.(*V0043License)
+	if ok {
+		utils.RemarshalOrDie(object, out)
+		o.Items = append(o.Items, *out)
+	}
+}
+
+// DeepCopyObjectList implements ObjectList.
+func (o *V0043LicenseList) DeepCopyObjectList() object.ObjectList {
+	out := new(V0043LicenseList)
+	out.Items = make([]V0043License, len(o.Items))
+	for i, item := range o.Items {
+		out.Items[i] = *item.DeepCopy()
+	}
+	return out
+}
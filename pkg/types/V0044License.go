@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0044"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+const (
+	ObjectTypeV0044License = "V0044License"
+)
+
+type V0044License struct {
+	api.V0044License
+}
+
+// GetKey implements Object.
+func (o *V0044License) GetKey() object.ObjectKey {
+	return object.ObjectKey(ptr.Deref(o.LicenseName, ""))
+}
+
+// GetType implements Object.
+func (o *V0044License) GetType() object.ObjectType {
+	return ObjectTypeV0044License
+}
+
+// DeepCopyObject implements Object.
+func (o *V0044License) DeepCopyObject() object.Object {
+	return o.DeepCopy()
+}
+
+func (o *V0044License) DeepCopy() *V0044License {
+	out := new(V0044License)
+	utils.RemarshalOrDie(o, out)
+	return out
+}
+
+type V0044LicenseList struct {
+	Items []V0044License
+}
+
+// GetType implements ObjectList.
+func (o *V0044LicenseList) GetType() object.ObjectType {
+	return ObjectTypeV0044License
+}
+
+// GetItems implements ObjectList.
+func (o *V0044LicenseList) GetItems() []object.Object {
+	list := make([]object.Object, len(o.Items))
+	for i, item := range o.Items {
+		list[i] = item.DeepCopyObject()
+	}
+	return list
+}
+
+// AppendItem implements ObjectList.
+func (o *V0044LicenseList) AppendItem(object object.Object) {
+	out, ok := object.(*V0044License)
+	if ok {
+		utils.RemarshalOrDie(object, out)
+		o.Items = append(o.Items, *out)
+	}
+}
+
+// DeepCopyObjectList implements ObjectList.
+func (o *V0044LicenseList) DeepCopyObjectList() object.ObjectList {
+	out := new(V0044LicenseList)
+	out.Items = make([]V0044License, len(o.Items))
+	for i, item := range o.Items {
+		out.Items[i] = *item.DeepCopy()
+	}
+	return out
+}
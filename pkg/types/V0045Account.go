@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"k8s.io/utils/ptr"
+	"k8s.io/utils/set"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+const (
+	ObjectTypeV0045Account = "V0045Account"
+)
+
+type V0045Account struct {
+	api.V0045Account
+}
+
+// GetKey implements Object.
+func (o *V0045Account) GetKey() object.ObjectKey {
+	return object.ObjectKey(o.Name)
+}
+
+// GetType implements Object.
+func (o *V0045Account) GetType() object.ObjectType {
+	return ObjectTypeV0045Account
+}
+
+// DeepCopyObject implements Object.
+func (o *V0045Account) DeepCopyObject() object.Object {
+	return o.DeepCopy()
+}
+
+func (o *V0045Account) DeepCopy() *V0045Account {
+	out := new(V0045Account)
+	utils.RemarshalOrDie(o, out)
+	return out
+}
+
+func (o *V0045Account) GetFlagsAsSet() set.Set[api.V0045AccountFlags] {
+	out := make(set.Set[api.V0045AccountFlags])
+	flags := ptr.Deref(o.Flags, []api.V0045AccountFlags{})
+	for _, f := range flags {
+		out.Insert(f)
+	}
+	return out
+}
+
+// GetCoordinatorNames returns the user names of the account coordinators.
+// Requires the account to be fetched with coordinators.
+func (o *V0045Account) GetCoordinatorNames() []string {
+	coords := ptr.Deref(o.Coordinators, api.V0045CoordList{})
+	out := make([]string, len(coords))
+	for i, coord := range coords {
+		out[i] = coord.Name
+	}
+	return out
+}
+
+type V0045AccountList struct {
+	Items []V0045Account
+}
+
+// GetType implements ObjectList.
+func (o *V0045AccountList) GetType() object.ObjectType {
+	return ObjectTypeV0045Account
+}
+
+// GetItems implements ObjectList.
+func (o *V0045AccountList) GetItems() []object.Object {
+	list := make([]object.Object, len(o.Items))
+	for i, item := range o.Items {
+		list[i] = item.DeepCopyObject()
+	}
+	return list
+}
+
+// AppendItem implements ObjectList.
+func (o *V0045AccountList) AppendItem(object object.Object) {
+	out, ok := object.(*V0045Account)
+	if ok {
+		utils.RemarshalOrDie(object, out)
+		o.Items = append(o.Items, *out)
+	}
+}
+
+// DeepCopyObjectList implements ObjectList.
+func (o *V0045AccountList) DeepCopyObjectList() object.ObjectList {
+	out := new(V0045AccountList)
+	out.Items = make([]V0045Account, len(o.Items))
+	for i, item := range o.Items {
+		out.Items[i] = *item.DeepCopy()
+	}
+	return out
+}
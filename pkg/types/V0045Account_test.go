@@ -0,0 +1,402 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/set"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+)
+
+func TestV0045Account_GetKey(t *testing.T) {
+	type fields struct {
+		V0045Account api.V0045Account
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectKey
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Account: api.V0045Account{},
+			},
+			want: "",
+		},
+		{
+			name: "key",
+			fields: fields{
+				V0045Account: api.V0045Account{Name: "test_0"},
+			},
+			want: "test_0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Account{
+				V0045Account: tt.fields.V0045Account,
+			}
+			got := o.GetKey()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Account_GetType(t *testing.T) {
+	type fields struct {
+		V0045Account api.V0045Account
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				V0045Account: api.V0045Account{},
+			},
+			want: ObjectTypeV0045Account,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Account{
+				V0045Account: tt.fields.V0045Account,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Account_DeepCopyObject(t *testing.T) {
+	type fields struct {
+		V0045Account api.V0045Account
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Account: api.V0045Account{},
+			},
+			want: &V0045Account{},
+		},
+		{
+			name: "id",
+			fields: fields{
+				V0045Account: api.V0045Account{Name: "test_0"},
+			},
+			want: &V0045Account{api.V0045Account{Name: "test_0"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Account{
+				V0045Account: tt.fields.V0045Account,
+			}
+			got := o.DeepCopyObject()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Account_DeepCopy(t *testing.T) {
+	type fields struct {
+		V0045Account api.V0045Account
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   *V0045Account
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Account: api.V0045Account{},
+			},
+			want: &V0045Account{},
+		},
+		{
+			name: "id",
+			fields: fields{
+				V0045Account: api.V0045Account{Name: "test_0"},
+			},
+			want: &V0045Account{api.V0045Account{Name: "test_0"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Account{
+				V0045Account: tt.fields.V0045Account,
+			}
+			got := o.DeepCopy()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045AccountList_GetType(t *testing.T) {
+	type fields struct {
+		Items []V0045Account
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				Items: []V0045Account{},
+			},
+			want: ObjectTypeV0045Account,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045AccountList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045AccountList_GetItems(t *testing.T) {
+	type fields struct {
+		Items []V0045Account
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   []object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045Account{},
+			},
+			want: []object.Object{},
+		},
+		{
+			name: "items",
+			fields: fields{
+				Items: []V0045Account{
+					{V0045Account: api.V0045Account{Name: "test_0"}},
+					{V0045Account: api.V0045Account{Name: "test_1"}},
+				},
+			},
+			want: []object.Object{
+				&V0045Account{api.V0045Account{Name: "test_0"}},
+				&V0045Account{api.V0045Account{Name: "test_1"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045AccountList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetItems()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045AccountList_AppendItem(t *testing.T) {
+	type fields struct {
+		Items []V0045Account
+	}
+	type args struct {
+		object object.Object
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		args       args
+		wantAppend bool
+	}{
+		{
+			name: "nil",
+			fields: fields{
+				Items: []V0045Account{},
+			},
+			args: args{
+				object: nil,
+			},
+			wantAppend: false,
+		},
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045Account{},
+			},
+			args: args{
+				object: &V0045Account{},
+			},
+			wantAppend: true,
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0045Account{
+					{V0045Account: api.V0045Account{Name: "test_0"}},
+					{V0045Account: api.V0045Account{Name: "test_1"}},
+				},
+			},
+			args: args{
+				object: &V0045Account{},
+			},
+			wantAppend: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045AccountList{
+				Items: tt.fields.Items,
+			}
+			want := len(o.GetItems())
+			if tt.wantAppend {
+				want++
+			}
+			o.AppendItem(tt.args.object)
+			got := len(o.GetItems())
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func TestV0045AccountList_DeepCopyObjectList(t *testing.T) {
+	type fields struct {
+		Items []V0045Account
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectList
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045Account{},
+			},
+			want: &V0045AccountList{
+				Items: []V0045Account{},
+			},
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0045Account{
+					{V0045Account: api.V0045Account{Name: "test_0"}},
+					{V0045Account: api.V0045Account{Name: "test_1"}},
+				},
+			},
+			want: &V0045AccountList{
+				Items: []V0045Account{
+					{api.V0045Account{Name: "test_0"}},
+					{api.V0045Account{Name: "test_1"}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045AccountList{
+				Items: tt.fields.Items,
+			}
+			got := o.DeepCopyObjectList()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Account_GetFlagsAsSet(t *testing.T) {
+	type fields struct {
+		V0045Account api.V0045Account
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   set.Set[api.V0045AccountFlags]
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Account: api.V0045Account{},
+			},
+			want: set.New[api.V0045AccountFlags](),
+		},
+		{
+			name: "multiple",
+			fields: fields{
+				V0045Account: api.V0045Account{
+					Flags: &[]api.V0045AccountFlags{api.V0045AccountFlagsDELETED, api.V0045AccountFlagsWithCoordinators},
+				},
+			},
+			want: set.New(api.V0045AccountFlagsDELETED, api.V0045AccountFlagsWithCoordinators),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Account{
+				V0045Account: tt.fields.V0045Account,
+			}
+			if got := o.GetFlagsAsSet(); !tt.want.Equal(got) {
+				t.Errorf("V0045Account.GetFlagsAsSet() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestV0045Account_GetCoordinatorNames(t *testing.T) {
+	type fields struct {
+		V0045Account api.V0045Account
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   []string
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Account: api.V0045Account{},
+			},
+			want: []string{},
+		},
+		{
+			name: "coordinators",
+			fields: fields{
+				V0045Account: api.V0045Account{
+					Coordinators: &api.V0045CoordList{
+						{Name: "foo"},
+						{Name: "bar"},
+					},
+				},
+			},
+			want: []string{"foo", "bar"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Account{
+				V0045Account: tt.fields.V0045Account,
+			}
+			got := o.GetCoordinatorNames()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,232 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/utils/ptr"
+	"k8s.io/utils/set"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+const (
+	ObjectTypeV0045AccountingJob = "V0045AccountingJob"
+)
+
+const (
+	tresTypeMem    = "mem"
+	tresTypeEnergy = "energy"
+)
+
+type V0045AccountingJob struct {
+	api.V0045Job
+}
+
+// GetKey implements Object.
+func (o *V0045AccountingJob) GetKey() object.ObjectKey {
+	jobId := ptr.Deref(o.JobId, 0)
+	return object.ObjectKey(fmt.Sprintf("%d", jobId))
+}
+
+// GetType implements Object.
+func (o *V0045AccountingJob) GetType() object.ObjectType {
+	return ObjectTypeV0045AccountingJob
+}
+
+// DeepCopyObject implements Object.
+func (o *V0045AccountingJob) DeepCopyObject() object.Object {
+	return o.DeepCopy()
+}
+
+func (o *V0045AccountingJob) DeepCopy() *V0045AccountingJob {
+	out := new(V0045AccountingJob)
+	utils.RemarshalOrDie(o, out)
+	return out
+}
+
+func (o *V0045AccountingJob) GetStateAsSet() set.Set[api.V0045JobStateCurrent] {
+	out := make(set.Set[api.V0045JobStateCurrent])
+	if o.State == nil {
+		return out
+	}
+	states := ptr.Deref(o.State.Current, []api.V0045JobStateCurrent{})
+	for _, s := range states {
+		out.Insert(s)
+	}
+	return out
+}
+
+// GetBeginTime returns the time the job became eligible to run, or when it was
+// submitted if that is unknown.
+func (o *V0045AccountingJob) GetBeginTime() time.Time {
+	if o.Time == nil {
+		return time.Time{}
+	}
+	if eligible := ptr.Deref(o.Time.Eligible, 0); eligible > 0 {
+		return time.Unix(eligible, 0)
+	}
+	if submission := ptr.Deref(o.Time.Submission, 0); submission > 0 {
+		return time.Unix(submission, 0)
+	}
+	return time.Time{}
+}
+
+// GetSteps returns the steps of the job.
+func (o *V0045AccountingJob) GetSteps() []V0045AccountingJobStep {
+	steps := ptr.Deref(o.Steps, api.V0045StepList{})
+	out := make([]V0045AccountingJobStep, len(steps))
+	for i, step := range steps {
+		out[i] = V0045AccountingJobStep{V0045Step: step}
+	}
+	return out
+}
+
+// GetTotalCPUTime returns the user and system CPU time used by all steps of the
+// job.
+func (o *V0045AccountingJob) GetTotalCPUTime() time.Duration {
+	var total time.Duration
+	for _, step := range o.GetSteps() {
+		total += step.GetCPUTime()
+	}
+	return total
+}
+
+// GetMaxRSS returns the largest resident set size of any task of the job, in
+// bytes.
+func (o *V0045AccountingJob) GetMaxRSS() int64 {
+	var maxRSS int64
+	for _, step := range o.GetSteps() {
+		maxRSS = max(maxRSS, step.GetMaxRSS())
+	}
+	return maxRSS
+}
+
+// GetTotalEnergy returns the energy consumed by the job, in joules. Energy is
+// measured per node, so the batch and extern steps, which run alongside the
+// other steps, are only counted when the job has no other steps.
+func (o *V0045AccountingJob) GetTotalEnergy() int64 {
+	var total, jobWide int64
+	var hasSteps bool
+	for _, step := range o.GetSteps() {
+		if step.isJobWide() {
+			jobWide = max(jobWide, step.GetEnergy())
+			continue
+		}
+		hasSteps = true
+		total += step.GetEnergy()
+	}
+	if !hasSteps {
+		return jobWide
+	}
+	return total
+}
+
+// V0045AccountingJobStep is a step of a V0045AccountingJob.
+type V0045AccountingJobStep struct {
+	api.V0045Step
+}
+
+// GetStepId returns the step id (e.g. "1234.batch").
+func (o *V0045AccountingJobStep) GetStepId() string {
+	if o.Step == nil {
+		return ""
+	}
+	return ptr.Deref(o.Step.Id, "")
+}
+
+// isJobWide returns true for the batch and extern steps, which span the
+// lifetime of the job rather than a single step of work.
+func (o *V0045AccountingJobStep) isJobWide() bool {
+	id := o.GetStepId()
+	return strings.HasSuffix(id, ".batch") || strings.HasSuffix(id, ".extern")
+}
+
+// GetCPUTime returns the user and system CPU time used by the step.
+func (o *V0045AccountingJobStep) GetCPUTime() time.Duration {
+	if o.Time == nil || o.Time.Total == nil {
+		return 0
+	}
+	seconds := time.Duration(ptr.Deref(o.Time.Total.Seconds, 0)) * time.Second
+	microseconds := time.Duration(ptr.Deref(o.Time.Total.Microseconds, 0)) * time.Microsecond
+	return seconds + microseconds
+}
+
+// GetMaxRSS returns the largest resident set size of any task of the step, in
+// bytes.
+func (o *V0045AccountingJobStep) GetMaxRSS() int64 {
+	if o.Tres == nil || o.Tres.Requested == nil {
+		return 0
+	}
+	usage := ptr.Deref(o.Tres.Requested.Max, api.V0045StepTresReqMax{})
+	return getTresCount(usage, tresTypeMem)
+}
+
+// GetEnergy returns the energy consumed by the step, in joules.
+func (o *V0045AccountingJobStep) GetEnergy() int64 {
+	if o.Tres != nil && o.Tres.Requested != nil && o.Tres.Requested.Total != nil {
+		if energy := getTresCount(*o.Tres.Requested.Total, tresTypeEnergy); energy > 0 {
+			return energy
+		}
+	}
+	if o.Statistics == nil || o.Statistics.Energy == nil || o.Statistics.Energy.Consumed == nil {
+		return 0
+	}
+	consumed := o.Statistics.Energy.Consumed
+	if !ptr.Deref(consumed.Set, false) || ptr.Deref(consumed.Infinite, false) {
+		return 0
+	}
+	return ptr.Deref(consumed.Number, 0)
+}
+
+func getTresCount(tres []api.V0045Tres, tresType string) int64 {
+	for _, item := range tres {
+		if item.Type == tresType && ptr.Deref(item.Name, "") == "" {
+			return ptr.Deref(item.Count, 0)
+		}
+	}
+	return 0
+}
+
+type V0045AccountingJobList struct {
+	Items []V0045AccountingJob
+}
+
+// GetType implements ObjectList.
+func (o *V0045AccountingJobList) GetType() object.ObjectType {
+	return ObjectTypeV0045AccountingJob
+}
+
+// GetItems implements ObjectList.
+func (o *V0045AccountingJobList) GetItems() []object.Object {
+	list := make([]object.Object, len(o.Items))
+	for i, item := range o.Items {
+		list[i] = item.DeepCopyObject()
+	}
+	return list
+}
+
+// AppendItem implements ObjectList.
+func (o *V0045AccountingJobList) AppendItem(object object.Object) {
+	out, ok := object.(*V0045AccountingJob)
+	if ok {
+		utils.RemarshalOrDie(object, out)
+		o.Items = append(o.Items, *out)
+	}
+}
+
+// DeepCopyObjectList implements ObjectList.
+func (o *V0045AccountingJobList) DeepCopyObjectList() object.ObjectList {
+	out := new(V0045AccountingJobList)
+	out.Items = make([]V0045AccountingJob, len(o.Items))
+	for i, item := range o.Items {
+		out.Items[i] = *item.DeepCopy()
+	}
+	return out
+}
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+)
+
+// V0045AccountingJobQuery builds the query parameters of a slurmdbd job
+// history request.
+type V0045AccountingJobQuery struct {
+	StartTime  time.Time
+	EndTime    time.Time
+	Accounts   []string
+	Users      []string
+	Partitions []string
+	States     []api.V0045JobStateCurrent
+	Nodes      []string
+}
+
+// NewV0045AccountingJobQuery returns an empty query.
+func NewV0045AccountingJobQuery() *V0045AccountingJobQuery {
+	return &V0045AccountingJobQuery{}
+}
+
+// Between restricts the query to jobs eligible within [start, end).
+func (q *V0045AccountingJobQuery) Between(start, end time.Time) *V0045AccountingJobQuery {
+	q.StartTime = start
+	q.EndTime = end
+	return q
+}
+
+// WithAccounts restricts the query to jobs that ran under any of accounts.
+func (q *V0045AccountingJobQuery) WithAccounts(accounts ...string) *V0045AccountingJobQuery {
+	q.Accounts = append(q.Accounts, accounts...)
+	return q
+}
+
+// WithUsers restricts the query to jobs owned by any of users.
+func (q *V0045AccountingJobQuery) WithUsers(users ...string) *V0045AccountingJobQuery {
+	q.Users = append(q.Users, users...)
+	return q
+}
+
+// WithPartitions restricts the query to jobs that ran in any of partitions.
+func (q *V0045AccountingJobQuery) WithPartitions(partitions ...string) *V0045AccountingJobQuery {
+	q.Partitions = append(q.Partitions, partitions...)
+	return q
+}
+
+// WithStates restricts the query to jobs in any of states.
+func (q *V0045AccountingJobQuery) WithStates(states ...api.V0045JobStateCurrent) *V0045AccountingJobQuery {
+	q.States = append(q.States, states...)
+	return q
+}
+
+// WithNodes restricts the query to jobs that ran on any of nodes, each a node
+// name or hostlist expression (e.g. "node[0-9]").
+func (q *V0045AccountingJobQuery) WithNodes(nodes ...string) *V0045AccountingJobQuery {
+	q.Nodes = append(q.Nodes, nodes...)
+	return q
+}
+
+// DeepCopy returns a copy of the query.
+func (q *V0045AccountingJobQuery) DeepCopy() *V0045AccountingJobQuery {
+	out := *q
+	out.Accounts = append([]string(nil), q.Accounts...)
+	out.Users = append([]string(nil), q.Users...)
+	out.Partitions = append([]string(nil), q.Partitions...)
+	out.States = append([]api.V0045JobStateCurrent(nil), q.States...)
+	out.Nodes = append([]string(nil), q.Nodes...)
+	return &out
+}
+
+// Split divides the time window of the query into consecutive windows of at
+// most size, returning one query per window. A query without a bounded time
+// window is returned as a single query.
+func (q *V0045AccountingJobQuery) Split(size time.Duration) []*V0045AccountingJobQuery {
+	if size <= 0 || q.StartTime.IsZero() || q.EndTime.IsZero() {
+		return []*V0045AccountingJobQuery{q.DeepCopy()}
+	}
+	out := []*V0045AccountingJobQuery{}
+	for start := q.StartTime; start.Before(q.EndTime); start = start.Add(size) {
+		end := start.Add(size)
+		if end.After(q.EndTime) {
+			end = q.EndTime
+		}
+		out = append(out, q.DeepCopy().Between(start, end))
+	}
+	return out
+}
+
+// Params returns the query as SlurmdbV0045GetJobsParams.
+func (q *V0045AccountingJobQuery) Params() *api.SlurmdbV0045GetJobsParams {
+	states := make([]string, len(q.States))
+	for i, state := range q.States {
+		states[i] = string(state)
+	}
+	return &api.SlurmdbV0045GetJobsParams{
+		StartTime: formatQueryTime(q.StartTime),
+		EndTime:   formatQueryTime(q.EndTime),
+		Account:   formatQueryList(q.Accounts),
+		Users:     formatQueryList(q.Users),
+		Partition: formatQueryList(q.Partitions),
+		State:     formatQueryList(states),
+		Node:      formatQueryList(q.Nodes),
+	}
+}
+
+func formatQueryTime(t time.Time) *string {
+	if t.IsZero() {
+		return nil
+	}
+	return ptr.To(strconv.FormatInt(t.Unix(), 10))
+}
+
+func formatQueryList(items []string) *string {
+	if len(items) == 0 {
+		return nil
+	}
+	return ptr.To(strings.Join(items, ","))
+}
@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+)
+
+func TestV0045AccountingJobQuery_Params(t *testing.T) {
+	tests := []struct {
+		name  string
+		query *V0045AccountingJobQuery
+		want  *api.SlurmdbV0045GetJobsParams
+	}{
+		{
+			name:  "empty",
+			query: NewV0045AccountingJobQuery(),
+			want:  &api.SlurmdbV0045GetJobsParams{},
+		},
+		{
+			name: "all filters",
+			query: NewV0045AccountingJobQuery().
+				Between(time.Unix(100, 0), time.Unix(200, 0)).
+				WithAccounts("foo", "bar").
+				WithUsers("alice").
+				WithPartitions("debug").
+				WithStates(api.V0045JobStateCurrentCOMPLETED, api.V0045JobStateCurrentFAILED).
+				WithNodes("node[0-9]"),
+			want: &api.SlurmdbV0045GetJobsParams{
+				StartTime: ptr.To("100"),
+				EndTime:   ptr.To("200"),
+				Account:   ptr.To("foo,bar"),
+				Users:     ptr.To("alice"),
+				Partition: ptr.To("debug"),
+				State:     ptr.To("COMPLETED,FAILED"),
+				Node:      ptr.To("node[0-9]"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.query.Params()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045AccountingJobQuery_DeepCopy(t *testing.T) {
+	query := NewV0045AccountingJobQuery().WithUsers("alice")
+	out := query.DeepCopy()
+	require.Equal(t, query, out)
+
+	out.WithUsers("bob")
+	require.Equal(t, []string{"alice"}, query.Users)
+}
+
+func TestV0045AccountingJobQuery_Split(t *testing.T) {
+	start := time.Unix(0, 0)
+	tests := []struct {
+		name  string
+		query *V0045AccountingJobQuery
+		size  time.Duration
+		want  [][2]time.Time
+	}{
+		{
+			name:  "unbounded",
+			query: NewV0045AccountingJobQuery(),
+			size:  time.Hour,
+			want:  [][2]time.Time{{}},
+		},
+		{
+			name:  "no size",
+			query: NewV0045AccountingJobQuery().Between(start, start.Add(time.Hour)),
+			size:  0,
+			want:  [][2]time.Time{{start, start.Add(time.Hour)}},
+		},
+		{
+			name:  "even",
+			query: NewV0045AccountingJobQuery().Between(start, start.Add(2*time.Hour)),
+			size:  time.Hour,
+			want: [][2]time.Time{
+				{start, start.Add(time.Hour)},
+				{start.Add(time.Hour), start.Add(2 * time.Hour)},
+			},
+		},
+		{
+			name:  "remainder",
+			query: NewV0045AccountingJobQuery().Between(start, start.Add(90*time.Minute)),
+			size:  time.Hour,
+			want: [][2]time.Time{
+				{start, start.Add(time.Hour)},
+				{start.Add(time.Hour), start.Add(90 * time.Minute)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.query.Split(tt.size)
+			windows := make([][2]time.Time, len(got))
+			for i, q := range got {
+				windows[i] = [2]time.Time{q.StartTime, q.EndTime}
+			}
+			require.Equal(t, tt.want, windows)
+		})
+	}
+}
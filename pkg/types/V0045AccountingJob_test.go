@@ -0,0 +1,603 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+	"k8s.io/utils/set"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+func TestV0045AccountingJob_GetKey(t *testing.T) {
+	type fields struct {
+		V0045Job api.V0045Job
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectKey
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Job: api.V0045Job{},
+			},
+			want: "0",
+		},
+		{
+			name: "key",
+			fields: fields{
+				V0045Job: api.V0045Job{JobId: ptr.To[int32](1)},
+			},
+			want: "1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045AccountingJob{
+				V0045Job: tt.fields.V0045Job,
+			}
+			got := o.GetKey()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045AccountingJob_GetType(t *testing.T) {
+	type fields struct {
+		V0045Job api.V0045Job
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				V0045Job: api.V0045Job{},
+			},
+			want: ObjectTypeV0045AccountingJob,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045AccountingJob{
+				V0045Job: tt.fields.V0045Job,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045AccountingJob_DeepCopyObject(t *testing.T) {
+	type fields struct {
+		V0045Job api.V0045Job
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Job: api.V0045Job{},
+			},
+			want: &V0045AccountingJob{},
+		},
+		{
+			name: "id",
+			fields: fields{
+				V0045Job: api.V0045Job{JobId: ptr.To[int32](1)},
+			},
+			want: &V0045AccountingJob{api.V0045Job{JobId: ptr.To[int32](1)}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045AccountingJob{
+				V0045Job: tt.fields.V0045Job,
+			}
+			got := o.DeepCopyObject()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045AccountingJob_DeepCopy(t *testing.T) {
+	type fields struct {
+		V0045Job api.V0045Job
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   *V0045AccountingJob
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Job: api.V0045Job{},
+			},
+			want: &V0045AccountingJob{},
+		},
+		{
+			name: "id",
+			fields: fields{
+				V0045Job: api.V0045Job{JobId: ptr.To[int32](1)},
+			},
+			want: &V0045AccountingJob{api.V0045Job{JobId: ptr.To[int32](1)}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045AccountingJob{
+				V0045Job: tt.fields.V0045Job,
+			}
+			got := o.DeepCopy()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045AccountingJobList_GetType(t *testing.T) {
+	type fields struct {
+		Items []V0045AccountingJob
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				Items: []V0045AccountingJob{},
+			},
+			want: ObjectTypeV0045AccountingJob,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045AccountingJobList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045AccountingJobList_GetItems(t *testing.T) {
+	type fields struct {
+		Items []V0045AccountingJob
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   []object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045AccountingJob{},
+			},
+			want: []object.Object{},
+		},
+		{
+			name: "items",
+			fields: fields{
+				Items: []V0045AccountingJob{
+					{V0045Job: api.V0045Job{JobId: ptr.To[int32](1)}},
+					{V0045Job: api.V0045Job{JobId: ptr.To[int32](2)}},
+				},
+			},
+			want: []object.Object{
+				&V0045AccountingJob{api.V0045Job{JobId: ptr.To[int32](1)}},
+				&V0045AccountingJob{api.V0045Job{JobId: ptr.To[int32](2)}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045AccountingJobList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetItems()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045AccountingJobList_AppendItem(t *testing.T) {
+	type fields struct {
+		Items []V0045AccountingJob
+	}
+	type args struct {
+		object object.Object
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		args       args
+		wantAppend bool
+	}{
+		{
+			name: "nil",
+			fields: fields{
+				Items: []V0045AccountingJob{},
+			},
+			args: args{
+				object: nil,
+			},
+			wantAppend: false,
+		},
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045AccountingJob{},
+			},
+			args: args{
+				object: &V0045AccountingJob{},
+			},
+			wantAppend: true,
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0045AccountingJob{
+					{V0045Job: api.V0045Job{JobId: ptr.To[int32](1)}},
+					{V0045Job: api.V0045Job{JobId: ptr.To[int32](2)}},
+				},
+			},
+			args: args{
+				object: &V0045AccountingJob{},
+			},
+			wantAppend: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045AccountingJobList{
+				Items: tt.fields.Items,
+			}
+			want := len(o.GetItems())
+			if tt.wantAppend {
+				want++
+			}
+			o.AppendItem(tt.args.object)
+			got := len(o.GetItems())
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func TestV0045AccountingJobList_DeepCopyObjectList(t *testing.T) {
+	type fields struct {
+		Items []V0045AccountingJob
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectList
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045AccountingJob{},
+			},
+			want: &V0045AccountingJobList{
+				Items: []V0045AccountingJob{},
+			},
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0045AccountingJob{
+					{V0045Job: api.V0045Job{JobId: ptr.To[int32](1)}},
+					{V0045Job: api.V0045Job{JobId: ptr.To[int32](2)}},
+				},
+			},
+			want: &V0045AccountingJobList{
+				Items: []V0045AccountingJob{
+					{api.V0045Job{JobId: ptr.To[int32](1)}},
+					{api.V0045Job{JobId: ptr.To[int32](2)}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045AccountingJobList{
+				Items: tt.fields.Items,
+			}
+			got := o.DeepCopyObjectList()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045AccountingJob_GetStateAsSet(t *testing.T) {
+	type fields struct {
+		V0045Job api.V0045Job
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   set.Set[api.V0045JobStateCurrent]
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Job: api.V0045Job{},
+			},
+			want: set.New[api.V0045JobStateCurrent](),
+		},
+		{
+			name: "multiple",
+			fields: fields{
+				V0045Job: api.V0045Job{
+					State: &struct {
+						Current *[]api.V0045JobStateCurrent `json:"current,omitempty"`
+						Reason  *string                     `json:"reason,omitempty"`
+					}{
+						Current: &[]api.V0045JobStateCurrent{api.V0045JobStateCurrentCOMPLETED, api.V0045JobStateCurrentREQUEUED},
+					},
+				},
+			},
+			want: set.New(api.V0045JobStateCurrentCOMPLETED, api.V0045JobStateCurrentREQUEUED),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045AccountingJob{
+				V0045Job: tt.fields.V0045Job,
+			}
+			if got := o.GetStateAsSet(); !tt.want.Equal(got) {
+				t.Errorf("V0045AccountingJob.GetStateAsSet() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestV0045AccountingJob_GetBeginTime(t *testing.T) {
+	type fields struct {
+		V0045Job api.V0045Job
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   time.Time
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Job: api.V0045Job{},
+			},
+			want: time.Time{},
+		},
+		{
+			name: "eligible",
+			fields: fields{
+				V0045Job: func() api.V0045Job {
+					job := api.V0045Job{}
+					utils.RemarshalOrDie(map[string]any{"time": map[string]any{"eligible": 200, "submission": 100}}, &job)
+					return job
+				}(),
+			},
+			want: time.Unix(200, 0),
+		},
+		{
+			name: "submission",
+			fields: fields{
+				V0045Job: func() api.V0045Job {
+					job := api.V0045Job{}
+					utils.RemarshalOrDie(map[string]any{"time": map[string]any{"eligible": 0, "submission": 100}}, &job)
+					return job
+				}(),
+			},
+			want: time.Unix(100, 0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045AccountingJob{
+				V0045Job: tt.fields.V0045Job,
+			}
+			require.Equal(t, tt.want, o.GetBeginTime())
+		})
+	}
+}
+
+func newTestV0045Step(in map[string]any) api.V0045Step {
+	out := api.V0045Step{}
+	utils.RemarshalOrDie(in, &out)
+	return out
+}
+
+var (
+	testV0045StepBatch = newTestV0045Step(map[string]any{
+		"step": map[string]any{"id": "1.batch"},
+		"time": map[string]any{
+			"total": map[string]any{"seconds": 10, "microseconds": 500000},
+		},
+		"tres": map[string]any{
+			"requested": map[string]any{
+				"max": []map[string]any{
+					{"type": "cpu", "count": 1},
+					{"type": "mem", "count": 1024},
+				},
+				"total": []map[string]any{
+					{"type": "energy", "count": 30},
+				},
+			},
+			"consumed": map[string]any{
+				"max": []map[string]any{
+					{"type": "mem", "count": 1},
+				},
+				"total": []map[string]any{
+					{"type": "energy", "count": 1},
+				},
+			},
+		},
+	})
+	testV0045StepZero = newTestV0045Step(map[string]any{
+		"step": map[string]any{"id": "1.0"},
+		"time": map[string]any{
+			"total": map[string]any{"seconds": 20},
+		},
+		"statistics": map[string]any{
+			"energy": map[string]any{
+				"consumed": map[string]any{"set": true, "number": 12},
+			},
+		},
+		"tres": map[string]any{
+			"requested": map[string]any{
+				"max": []map[string]any{
+					{"type": "mem", "count": 4096},
+					{"type": "gres", "name": "gpumem", "count": 8192},
+				},
+			},
+		},
+	})
+)
+
+func TestV0045AccountingJob_GetSteps(t *testing.T) {
+	type fields struct {
+		V0045Job api.V0045Job
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   []string
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Job: api.V0045Job{},
+			},
+			want: []string{},
+		},
+		{
+			name: "steps",
+			fields: fields{
+				V0045Job: api.V0045Job{
+					Steps: &api.V0045StepList{testV0045StepBatch, testV0045StepZero},
+				},
+			},
+			want: []string{"1.batch", "1.0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045AccountingJob{
+				V0045Job: tt.fields.V0045Job,
+			}
+			got := []string{}
+			for _, step := range o.GetSteps() {
+				got = append(got, step.GetStepId())
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045AccountingJob_Totals(t *testing.T) {
+	type fields struct {
+		V0045Job api.V0045Job
+	}
+	tests := []struct {
+		name        string
+		fields      fields
+		wantCPUTime time.Duration
+		wantMaxRSS  int64
+		wantEnergy  int64
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Job: api.V0045Job{},
+			},
+		},
+		{
+			name: "steps",
+			fields: fields{
+				V0045Job: api.V0045Job{
+					Steps: &api.V0045StepList{testV0045StepBatch, testV0045StepZero},
+				},
+			},
+			wantCPUTime: 30*time.Second + 500*time.Millisecond,
+			wantMaxRSS:  4096,
+			wantEnergy:  12,
+		},
+		{
+			name: "batch step only",
+			fields: fields{
+				V0045Job: api.V0045Job{
+					Steps: &api.V0045StepList{testV0045StepBatch},
+				},
+			},
+			wantCPUTime: 10*time.Second + 500*time.Millisecond,
+			wantMaxRSS:  1024,
+			wantEnergy:  30,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045AccountingJob{
+				V0045Job: tt.fields.V0045Job,
+			}
+			require.Equal(t, tt.wantCPUTime, o.GetTotalCPUTime())
+			require.Equal(t, tt.wantMaxRSS, o.GetMaxRSS())
+			require.Equal(t, tt.wantEnergy, o.GetTotalEnergy())
+		})
+	}
+}
+
+func TestV0045AccountingJobStep(t *testing.T) {
+	tests := []struct {
+		name        string
+		step        api.V0045Step
+		wantCPUTime time.Duration
+		wantMaxRSS  int64
+		wantEnergy  int64
+	}{
+		{
+			name: "empty",
+			step: api.V0045Step{},
+		},
+		{
+			name:        "energy from tres",
+			step:        testV0045StepBatch,
+			wantCPUTime: 10*time.Second + 500*time.Millisecond,
+			wantMaxRSS:  1024,
+			wantEnergy:  30,
+		},
+		{
+			name:        "energy from statistics",
+			step:        testV0045StepZero,
+			wantCPUTime: 20 * time.Second,
+			wantMaxRSS:  4096,
+			wantEnergy:  12,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045AccountingJobStep{
+				V0045Step: tt.step,
+			}
+			require.Equal(t, tt.wantCPUTime, o.GetCPUTime())
+			require.Equal(t, tt.wantMaxRSS, o.GetMaxRSS())
+			require.Equal(t, tt.wantEnergy, o.GetEnergy())
+		})
+	}
+}
@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"k8s.io/utils/ptr"
+	"k8s.io/utils/set"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+const (
+	ObjectTypeV0045Association = "V0045Association"
+)
+
+type V0045Association struct {
+	api.V0045Assoc
+}
+
+// GetKey implements Object.
+func (o *V0045Association) GetKey() object.ObjectKey {
+	return o.GetAssociationKey().ObjectKey()
+}
+
+// GetType implements Object.
+func (o *V0045Association) GetType() object.ObjectType {
+	return ObjectTypeV0045Association
+}
+
+// DeepCopyObject implements Object.
+func (o *V0045Association) DeepCopyObject() object.Object {
+	return o.DeepCopy()
+}
+
+func (o *V0045Association) DeepCopy() *V0045Association {
+	out := new(V0045Association)
+	utils.RemarshalOrDie(o, out)
+	return out
+}
+
+// GetAssociationKey returns the fields which identify the association.
+func (o *V0045Association) GetAssociationKey() AssociationKey {
+	return AssociationKey{
+		Cluster:   ptr.Deref(o.Cluster, ""),
+		Account:   ptr.Deref(o.Account, ""),
+		User:      o.User,
+		Partition: ptr.Deref(o.Partition, ""),
+	}
+}
+
+func (o *V0045Association) GetFlagsAsSet() set.Set[api.V0045AssocFlags] {
+	out := make(set.Set[api.V0045AssocFlags])
+	flags := ptr.Deref(o.Flags, []api.V0045AssocFlags{})
+	for _, f := range flags {
+		out.Insert(f)
+	}
+	return out
+}
+
+// GetMaxJobs returns the MaxJobs limit, and whether it is set.
+func (o *V0045Association) GetMaxJobs() (int32, bool) {
+	if o.Max == nil || o.Max.Jobs == nil || o.Max.Jobs.Per == nil || o.Max.Jobs.Per.Count == nil {
+		return 0, false
+	}
+	count := o.Max.Jobs.Per.Count
+	if !ptr.Deref(count.Set, false) || ptr.Deref(count.Infinite, false) {
+		return 0, false
+	}
+	return ptr.Deref(count.Number, 0), true
+}
+
+// SetMaxJobs sets the MaxJobs limit.
+func (o *V0045Association) SetMaxJobs(maxJobs int32) {
+	// Unmarshal merges into the nested anonymous structs, allocating as needed.
+	utils.RemarshalOrDie(map[string]any{
+		"max": map[string]any{
+			"jobs": map[string]any{
+				"per": map[string]any{
+					"count": api.V0045Uint32NoValStruct{
+						Number: ptr.To(maxJobs),
+						Set:    ptr.To(true),
+					},
+				},
+			},
+		},
+	}, &o.V0045Assoc)
+}
+
+// GetGrpTRES returns the GrpTRES limit.
+func (o *V0045Association) GetGrpTRES() api.V0045TresList {
+	if o.Max == nil || o.Max.Tres == nil {
+		return api.V0045TresList{}
+	}
+	return ptr.Deref(o.Max.Tres.Total, api.V0045TresList{})
+}
+
+// SetGrpTRES sets the GrpTRES limit.
+func (o *V0045Association) SetGrpTRES(tres api.V0045TresList) {
+	// Unmarshal merges into the nested anonymous structs, allocating as needed.
+	utils.RemarshalOrDie(map[string]any{
+		"max": map[string]any{
+			"tres": map[string]any{
+				"total": tres,
+			},
+		},
+	}, &o.V0045Assoc)
+}
+
+type V0045AssociationList struct {
+	Items []V0045Association
+}
+
+// GetType implements ObjectList.
+func (o *V0045AssociationList) GetType() object.ObjectType {
+	return ObjectTypeV0045Association
+}
+
+// GetItems implements ObjectList.
+func (o *V0045AssociationList) GetItems() []object.Object {
+	list := make([]object.Object, len(o.Items))
+	for i, item := range o.Items {
+		list[i] = item.DeepCopyObject()
+	}
+	return list
+}
+
+// AppendItem implements ObjectList.
+func (o *V0045AssociationList) AppendItem(object object.Object) {
+	out, ok := object.(*V0045Association)
+	if ok {
+		utils.RemarshalOrDie(object, out)
+		o.Items = append(o.Items, *out)
+	}
+}
+
+// DeepCopyObjectList implements ObjectList.
+func (o *V0045AssociationList) DeepCopyObjectList() object.ObjectList {
+	out := new(V0045AssociationList)
+	out.Items = make([]V0045Association, len(o.Items))
+	for i, item := range o.Items {
+		out.Items[i] = *item.DeepCopy()
+	}
+	return out
+}
@@ -0,0 +1,435 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+	"k8s.io/utils/set"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+)
+
+func TestV0045Association_GetKey(t *testing.T) {
+	type fields struct {
+		V0045Assoc api.V0045Assoc
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectKey
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Assoc: api.V0045Assoc{},
+			},
+			want: "///",
+		},
+		{
+			name: "key",
+			fields: fields{
+				V0045Assoc: api.V0045Assoc{
+					Cluster:   ptr.To("linux"),
+					Account:   ptr.To("root"),
+					User:      "test_0",
+					Partition: ptr.To("debug"),
+				},
+			},
+			want: "linux/root/test_0/debug",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Association{
+				V0045Assoc: tt.fields.V0045Assoc,
+			}
+			got := o.GetKey()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Association_GetType(t *testing.T) {
+	type fields struct {
+		V0045Assoc api.V0045Assoc
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				V0045Assoc: api.V0045Assoc{},
+			},
+			want: ObjectTypeV0045Association,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Association{
+				V0045Assoc: tt.fields.V0045Assoc,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Association_DeepCopyObject(t *testing.T) {
+	type fields struct {
+		V0045Assoc api.V0045Assoc
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Assoc: api.V0045Assoc{},
+			},
+			want: &V0045Association{},
+		},
+		{
+			name: "id",
+			fields: fields{
+				V0045Assoc: api.V0045Assoc{User: "test_0"},
+			},
+			want: &V0045Association{api.V0045Assoc{User: "test_0"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Association{
+				V0045Assoc: tt.fields.V0045Assoc,
+			}
+			got := o.DeepCopyObject()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Association_DeepCopy(t *testing.T) {
+	type fields struct {
+		V0045Assoc api.V0045Assoc
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   *V0045Association
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Assoc: api.V0045Assoc{},
+			},
+			want: &V0045Association{},
+		},
+		{
+			name: "id",
+			fields: fields{
+				V0045Assoc: api.V0045Assoc{User: "test_0"},
+			},
+			want: &V0045Association{api.V0045Assoc{User: "test_0"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Association{
+				V0045Assoc: tt.fields.V0045Assoc,
+			}
+			got := o.DeepCopy()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045AssociationList_GetType(t *testing.T) {
+	type fields struct {
+		Items []V0045Association
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				Items: []V0045Association{},
+			},
+			want: ObjectTypeV0045Association,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045AssociationList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045AssociationList_GetItems(t *testing.T) {
+	type fields struct {
+		Items []V0045Association
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   []object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045Association{},
+			},
+			want: []object.Object{},
+		},
+		{
+			name: "items",
+			fields: fields{
+				Items: []V0045Association{
+					{V0045Assoc: api.V0045Assoc{User: "test_0"}},
+					{V0045Assoc: api.V0045Assoc{User: "test_1"}},
+				},
+			},
+			want: []object.Object{
+				&V0045Association{api.V0045Assoc{User: "test_0"}},
+				&V0045Association{api.V0045Assoc{User: "test_1"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045AssociationList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetItems()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045AssociationList_AppendItem(t *testing.T) {
+	type fields struct {
+		Items []V0045Association
+	}
+	type args struct {
+		object object.Object
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		args       args
+		wantAppend bool
+	}{
+		{
+			name: "nil",
+			fields: fields{
+				Items: []V0045Association{},
+			},
+			args: args{
+				object: nil,
+			},
+			wantAppend: false,
+		},
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045Association{},
+			},
+			args: args{
+				object: &V0045Association{},
+			},
+			wantAppend: true,
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0045Association{
+					{V0045Assoc: api.V0045Assoc{User: "test_0"}},
+					{V0045Assoc: api.V0045Assoc{User: "test_1"}},
+				},
+			},
+			args: args{
+				object: &V0045Association{},
+			},
+			wantAppend: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045AssociationList{
+				Items: tt.fields.Items,
+			}
+			want := len(o.GetItems())
+			if tt.wantAppend {
+				want++
+			}
+			o.AppendItem(tt.args.object)
+			got := len(o.GetItems())
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func TestV0045AssociationList_DeepCopyObjectList(t *testing.T) {
+	type fields struct {
+		Items []V0045Association
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectList
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045Association{},
+			},
+			want: &V0045AssociationList{
+				Items: []V0045Association{},
+			},
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0045Association{
+					{V0045Assoc: api.V0045Assoc{User: "test_0"}},
+					{V0045Assoc: api.V0045Assoc{User: "test_1"}},
+				},
+			},
+			want: &V0045AssociationList{
+				Items: []V0045Association{
+					{api.V0045Assoc{User: "test_0"}},
+					{api.V0045Assoc{User: "test_1"}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045AssociationList{
+				Items: tt.fields.Items,
+			}
+			got := o.DeepCopyObjectList()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Association_GetAssociationKey(t *testing.T) {
+	type fields struct {
+		V0045Assoc api.V0045Assoc
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   AssociationKey
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Assoc: api.V0045Assoc{},
+			},
+			want: AssociationKey{},
+		},
+		{
+			name: "key",
+			fields: fields{
+				V0045Assoc: api.V0045Assoc{
+					Cluster: ptr.To("linux"),
+					Account: ptr.To("root"),
+					User:    "test_0",
+				},
+			},
+			want: AssociationKey{Cluster: "linux", Account: "root", User: "test_0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Association{
+				V0045Assoc: tt.fields.V0045Assoc,
+			}
+			got := o.GetAssociationKey()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Association_GetFlagsAsSet(t *testing.T) {
+	type fields struct {
+		V0045Assoc api.V0045Assoc
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   set.Set[api.V0045AssocFlags]
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Assoc: api.V0045Assoc{},
+			},
+			want: set.New[api.V0045AssocFlags](),
+		},
+		{
+			name: "multiple",
+			fields: fields{
+				V0045Assoc: api.V0045Assoc{
+					Flags: &[]api.V0045AssocFlags{api.V0045AssocFlagsDELETED, api.V0045AssocFlagsExact},
+				},
+			},
+			want: set.New(api.V0045AssocFlagsDELETED, api.V0045AssocFlagsExact),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Association{
+				V0045Assoc: tt.fields.V0045Assoc,
+			}
+			if got := o.GetFlagsAsSet(); !tt.want.Equal(got) {
+				t.Errorf("V0045Association.GetFlagsAsSet() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestV0045Association_MaxJobs(t *testing.T) {
+	o := &V0045Association{}
+	_, ok := o.GetMaxJobs()
+	require.False(t, ok)
+
+	o.SetMaxJobs(10)
+	got, ok := o.GetMaxJobs()
+	require.True(t, ok)
+	require.Equal(t, int32(10), got)
+
+	o.SetGrpTRES(api.V0045TresList{{Type: "cpu", Count: ptr.To[int64](4)}})
+	got, ok = o.GetMaxJobs()
+	require.True(t, ok)
+	require.Equal(t, int32(10), got)
+}
+
+func TestV0045Association_GrpTRES(t *testing.T) {
+	o := &V0045Association{}
+	require.Equal(t, api.V0045TresList{}, o.GetGrpTRES())
+
+	tres := api.V0045TresList{{Type: "cpu", Count: ptr.To[int64](4)}}
+	o.SetGrpTRES(tres)
+	require.Equal(t, tres, o.GetGrpTRES())
+
+	o.SetMaxJobs(10)
+	require.Equal(t, tres, o.GetGrpTRES())
+}
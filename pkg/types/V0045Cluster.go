@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"net"
+	"strconv"
+
+	"k8s.io/utils/ptr"
+	"k8s.io/utils/set"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+const (
+	ObjectTypeV0045Cluster = "V0045Cluster"
+)
+
+type V0045Cluster struct {
+	api.V0045ClusterRec
+}
+
+// GetKey implements Object.
+func (o *V0045Cluster) GetKey() object.ObjectKey {
+	return object.ObjectKey(ptr.Deref(o.Name, ""))
+}
+
+// GetType implements Object.
+func (o *V0045Cluster) GetType() object.ObjectType {
+	return ObjectTypeV0045Cluster
+}
+
+// DeepCopyObject implements Object.
+func (o *V0045Cluster) DeepCopyObject() object.Object {
+	return o.DeepCopy()
+}
+
+func (o *V0045Cluster) DeepCopy() *V0045Cluster {
+	out := new(V0045Cluster)
+	utils.RemarshalOrDie(o, out)
+	return out
+}
+
+func (o *V0045Cluster) GetFlagsAsSet() set.Set[api.V0045ClusterRecFlags] {
+	out := make(set.Set[api.V0045ClusterRecFlags])
+	flags := ptr.Deref(o.Flags, []api.V0045ClusterRecFlags{})
+	for _, f := range flags {
+		out.Insert(f)
+	}
+	return out
+}
+
+// GetControllerHost returns the slurmctld host registered for the cluster.
+func (o *V0045Cluster) GetControllerHost() string {
+	if o.Controller == nil {
+		return ""
+	}
+	return ptr.Deref(o.Controller.Host, "")
+}
+
+// GetControllerPort returns the slurmctld port registered for the cluster.
+func (o *V0045Cluster) GetControllerPort() int32 {
+	if o.Controller == nil {
+		return 0
+	}
+	return ptr.Deref(o.Controller.Port, 0)
+}
+
+// GetControllerAddress returns the slurmctld "host:port", or empty if the
+// cluster has not registered a controller.
+func (o *V0045Cluster) GetControllerAddress() string {
+	host := o.GetControllerHost()
+	if host == "" {
+		return ""
+	}
+	return net.JoinHostPort(host, strconv.Itoa(int(o.GetControllerPort())))
+}
+
+// GetRpcVersion returns the RPC protocol version used by the cluster.
+func (o *V0045Cluster) GetRpcVersion() int32 {
+	return ptr.Deref(o.RpcVersion, 0)
+}
+
+// IsFederated reports whether the cluster is a member of a federation.
+func (o *V0045Cluster) IsFederated() bool {
+	return o.GetFlagsAsSet().Has(api.V0045ClusterRecFlagsFEDERATION)
+}
+
+type V0045ClusterList struct {
+	Items []V0045Cluster
+}
+
+// GetType implements ObjectList.
+func (o *V0045ClusterList) GetType() object.ObjectType {
+	return ObjectTypeV0045Cluster
+}
+
+// GetItems implements ObjectList.
+func (o *V0045ClusterList) GetItems() []object.Object {
+	list := make([]object.Object, len(o.Items))
+	for i, item := range o.Items {
+		list[i] = item.DeepCopyObject()
+	}
+	return list
+}
+
+// AppendItem implements ObjectList.
+func (o *V0045ClusterList) AppendItem(object object.Object) {
+	out, ok := object.(*V0045Cluster)
+	if ok {
+		utils.RemarshalOrDie(object, out)
+		o.Items = append(o.Items, *out)
+	}
+}
+
+// DeepCopyObjectList implements ObjectList.
+func (o *V0045ClusterList) DeepCopyObjectList() object.ObjectList {
+	out := new(V0045ClusterList)
+	out.Items = make([]V0045Cluster, len(o.Items))
+	for i, item := range o.Items {
+		out.Items[i] = *item.DeepCopy()
+	}
+	return out
+}
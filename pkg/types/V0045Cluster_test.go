@@ -0,0 +1,507 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+	"k8s.io/utils/set"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+)
+
+func TestV0045Cluster_GetKey(t *testing.T) {
+	type fields struct {
+		V0045ClusterRec api.V0045ClusterRec
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectKey
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045ClusterRec: api.V0045ClusterRec{},
+			},
+			want: "",
+		},
+		{
+			name: "key",
+			fields: fields{
+				V0045ClusterRec: api.V0045ClusterRec{Name: ptr.To("test_0")},
+			},
+			want: "test_0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Cluster{
+				V0045ClusterRec: tt.fields.V0045ClusterRec,
+			}
+			got := o.GetKey()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Cluster_GetType(t *testing.T) {
+	type fields struct {
+		V0045ClusterRec api.V0045ClusterRec
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				V0045ClusterRec: api.V0045ClusterRec{},
+			},
+			want: ObjectTypeV0045Cluster,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Cluster{
+				V0045ClusterRec: tt.fields.V0045ClusterRec,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Cluster_DeepCopyObject(t *testing.T) {
+	type fields struct {
+		V0045ClusterRec api.V0045ClusterRec
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045ClusterRec: api.V0045ClusterRec{},
+			},
+			want: &V0045Cluster{},
+		},
+		{
+			name: "id",
+			fields: fields{
+				V0045ClusterRec: api.V0045ClusterRec{Name: ptr.To("test_0")},
+			},
+			want: &V0045Cluster{api.V0045ClusterRec{Name: ptr.To("test_0")}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Cluster{
+				V0045ClusterRec: tt.fields.V0045ClusterRec,
+			}
+			got := o.DeepCopyObject()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Cluster_DeepCopy(t *testing.T) {
+	type fields struct {
+		V0045ClusterRec api.V0045ClusterRec
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   *V0045Cluster
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045ClusterRec: api.V0045ClusterRec{},
+			},
+			want: &V0045Cluster{},
+		},
+		{
+			name: "id",
+			fields: fields{
+				V0045ClusterRec: api.V0045ClusterRec{Name: ptr.To("test_0")},
+			},
+			want: &V0045Cluster{api.V0045ClusterRec{Name: ptr.To("test_0")}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Cluster{
+				V0045ClusterRec: tt.fields.V0045ClusterRec,
+			}
+			got := o.DeepCopy()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045ClusterList_GetType(t *testing.T) {
+	type fields struct {
+		Items []V0045Cluster
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				Items: []V0045Cluster{},
+			},
+			want: ObjectTypeV0045Cluster,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045ClusterList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045ClusterList_GetItems(t *testing.T) {
+	type fields struct {
+		Items []V0045Cluster
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   []object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045Cluster{},
+			},
+			want: []object.Object{},
+		},
+		{
+			name: "items",
+			fields: fields{
+				Items: []V0045Cluster{
+					{V0045ClusterRec: api.V0045ClusterRec{Name: ptr.To("test_0")}},
+					{V0045ClusterRec: api.V0045ClusterRec{Name: ptr.To("test_1")}},
+				},
+			},
+			want: []object.Object{
+				&V0045Cluster{api.V0045ClusterRec{Name: ptr.To("test_0")}},
+				&V0045Cluster{api.V0045ClusterRec{Name: ptr.To("test_1")}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045ClusterList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetItems()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045ClusterList_AppendItem(t *testing.T) {
+	type fields struct {
+		Items []V0045Cluster
+	}
+	type args struct {
+		object object.Object
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		args       args
+		wantAppend bool
+	}{
+		{
+			name: "nil",
+			fields: fields{
+				Items: []V0045Cluster{},
+			},
+			args: args{
+				object: nil,
+			},
+			wantAppend: false,
+		},
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045Cluster{},
+			},
+			args: args{
+				object: &V0045Cluster{},
+			},
+			wantAppend: true,
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0045Cluster{
+					{V0045ClusterRec: api.V0045ClusterRec{Name: ptr.To("test_0")}},
+					{V0045ClusterRec: api.V0045ClusterRec{Name: ptr.To("test_1")}},
+				},
+			},
+			args: args{
+				object: &V0045Cluster{},
+			},
+			wantAppend: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045ClusterList{
+				Items: tt.fields.Items,
+			}
+			want := len(o.GetItems())
+			if tt.wantAppend {
+				want++
+			}
+			o.AppendItem(tt.args.object)
+			got := len(o.GetItems())
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func TestV0045ClusterList_DeepCopyObjectList(t *testing.T) {
+	type fields struct {
+		Items []V0045Cluster
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectList
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045Cluster{},
+			},
+			want: &V0045ClusterList{
+				Items: []V0045Cluster{},
+			},
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0045Cluster{
+					{V0045ClusterRec: api.V0045ClusterRec{Name: ptr.To("test_0")}},
+					{V0045ClusterRec: api.V0045ClusterRec{Name: ptr.To("test_1")}},
+				},
+			},
+			want: &V0045ClusterList{
+				Items: []V0045Cluster{
+					{api.V0045ClusterRec{Name: ptr.To("test_0")}},
+					{api.V0045ClusterRec{Name: ptr.To("test_1")}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045ClusterList{
+				Items: tt.fields.Items,
+			}
+			got := o.DeepCopyObjectList()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Cluster_GetFlagsAsSet(t *testing.T) {
+	type fields struct {
+		V0045ClusterRec api.V0045ClusterRec
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   set.Set[api.V0045ClusterRecFlags]
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045ClusterRec: api.V0045ClusterRec{},
+			},
+			want: set.New[api.V0045ClusterRecFlags](),
+		},
+		{
+			name: "multiple",
+			fields: fields{
+				V0045ClusterRec: api.V0045ClusterRec{
+					Flags: &[]api.V0045ClusterRecFlags{api.V0045ClusterRecFlagsDELETED, api.V0045ClusterRecFlagsFEDERATION},
+				},
+			},
+			want: set.New(api.V0045ClusterRecFlagsDELETED, api.V0045ClusterRecFlagsFEDERATION),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Cluster{
+				V0045ClusterRec: tt.fields.V0045ClusterRec,
+			}
+			if got := o.GetFlagsAsSet(); !tt.want.Equal(got) {
+				t.Errorf("V0045Cluster.GetFlagsAsSet() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestV0045Cluster_GetControllerAddress(t *testing.T) {
+	type fields struct {
+		V0045ClusterRec api.V0045ClusterRec
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		wantHost string
+		wantPort int32
+		want     string
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045ClusterRec: api.V0045ClusterRec{},
+			},
+			want: "",
+		},
+		{
+			name: "host and port",
+			fields: fields{
+				V0045ClusterRec: api.V0045ClusterRec{
+					Controller: &struct {
+						Host *string `json:"host,omitempty"`
+						Port *int32  `json:"port,omitempty"`
+					}{
+						Host: ptr.To("slurmctld"),
+						Port: ptr.To[int32](6817),
+					},
+				},
+			},
+			wantHost: "slurmctld",
+			wantPort: 6817,
+			want:     "slurmctld:6817",
+		},
+		{
+			name: "ipv6",
+			fields: fields{
+				V0045ClusterRec: api.V0045ClusterRec{
+					Controller: &struct {
+						Host *string `json:"host,omitempty"`
+						Port *int32  `json:"port,omitempty"`
+					}{
+						Host: ptr.To("fd00::1"),
+						Port: ptr.To[int32](6817),
+					},
+				},
+			},
+			wantHost: "fd00::1",
+			wantPort: 6817,
+			want:     "[fd00::1]:6817",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Cluster{
+				V0045ClusterRec: tt.fields.V0045ClusterRec,
+			}
+			require.Equal(t, tt.wantHost, o.GetControllerHost())
+			require.Equal(t, tt.wantPort, o.GetControllerPort())
+			require.Equal(t, tt.want, o.GetControllerAddress())
+		})
+	}
+}
+
+func TestV0045Cluster_GetRpcVersion(t *testing.T) {
+	type fields struct {
+		V0045ClusterRec api.V0045ClusterRec
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   int32
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045ClusterRec: api.V0045ClusterRec{},
+			},
+			want: 0,
+		},
+		{
+			name: "set",
+			fields: fields{
+				V0045ClusterRec: api.V0045ClusterRec{RpcVersion: ptr.To[int32](11264)},
+			},
+			want: 11264,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Cluster{
+				V0045ClusterRec: tt.fields.V0045ClusterRec,
+			}
+			require.Equal(t, tt.want, o.GetRpcVersion())
+		})
+	}
+}
+
+func TestV0045Cluster_IsFederated(t *testing.T) {
+	type fields struct {
+		V0045ClusterRec api.V0045ClusterRec
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   bool
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045ClusterRec: api.V0045ClusterRec{},
+			},
+			want: false,
+		},
+		{
+			name: "other flags",
+			fields: fields{
+				V0045ClusterRec: api.V0045ClusterRec{
+					Flags: &[]api.V0045ClusterRecFlags{api.V0045ClusterRecFlagsEXTERNAL},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "federated",
+			fields: fields{
+				V0045ClusterRec: api.V0045ClusterRec{
+					Flags: &[]api.V0045ClusterRecFlags{api.V0045ClusterRecFlagsFEDERATION},
+				},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Cluster{
+				V0045ClusterRec: tt.fields.V0045ClusterRec,
+			}
+			require.Equal(t, tt.want, o.IsFederated())
+		})
+	}
+}
@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"strings"
+	"time"
+
+	"k8s.io/utils/ptr"
+	"k8s.io/utils/set"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+const (
+	ObjectTypeV0045Conf = "V0045Conf"
+)
+
+type V0045Conf struct {
+	api.V0045SlurmConf
+
+	// Meta describes the configuration as loaded by the controller.
+	Meta api.V0045SlurmConfMeta `json:"slurm_conf_meta"`
+}
+
+// GetKey implements Object.
+func (o *V0045Conf) GetKey() object.ObjectKey {
+	return ""
+}
+
+// GetType implements Object.
+func (o *V0045Conf) GetType() object.ObjectType {
+	return ObjectTypeV0045Conf
+}
+
+// DeepCopyObject implements Object.
+func (o *V0045Conf) DeepCopyObject() object.Object {
+	return o.DeepCopy()
+}
+
+func (o *V0045Conf) DeepCopy() *V0045Conf {
+	out := new(V0045Conf)
+	utils.RemarshalOrDie(o, out)
+	return out
+}
+
+// GetHashValue returns the hash of the slurm.conf the controller is running.
+func (o *V0045Conf) GetHashValue() int32 {
+	return ptr.Deref(o.Meta.HashValue, 0)
+}
+
+// GetLastUpdate returns when the controller last loaded its configuration.
+func (o *V0045Conf) GetLastUpdate() time.Time {
+	return time.Unix(ptr.Deref(o.Meta.LastUpdate, 0), 0)
+}
+
+// GetSchedulerParameters returns SchedulerParameters as a map. Flags without
+// a value are mapped to the empty string.
+func (o *V0045Conf) GetSchedulerParameters() map[string]string {
+	return parseConfParameters(ptr.Deref(o.SchedulerParameters, []string{}))
+}
+
+// GetSchedulerType returns the SchedulerType (e.g. "sched/backfill").
+func (o *V0045Conf) GetSchedulerType() string {
+	return ptr.Deref(o.SchedulerType, "")
+}
+
+// GetSelectType returns the SelectType (e.g. "select/cons_tres").
+func (o *V0045Conf) GetSelectType() string {
+	return ptr.Deref(o.SelectType, "")
+}
+
+// GetSelectTypeParametersAsSet returns the SelectTypeParameters as a set.
+func (o *V0045Conf) GetSelectTypeParametersAsSet() set.Set[api.V0045SlurmConfSelectTypeParameters] {
+	out := make(set.Set[api.V0045SlurmConfSelectTypeParameters])
+	params := ptr.Deref(o.SelectTypeParameters, []api.V0045SlurmConfSelectTypeParameters{})
+	for _, p := range params {
+		out.Insert(p)
+	}
+	return out
+}
+
+// GetMaxJobCount returns the MaxJobCount.
+func (o *V0045Conf) GetMaxJobCount() int32 {
+	return ptr.Deref(o.MaxJobCount, 0)
+}
+
+// GetAuthType returns the AuthType (e.g. "auth/slurm").
+func (o *V0045Conf) GetAuthType() string {
+	return ptr.Deref(o.AuthType, "")
+}
+
+// GetAuthAltTypes returns the AuthAltTypes (e.g. ["auth/jwt"]).
+func (o *V0045Conf) GetAuthAltTypes() []string {
+	return ptr.Deref(o.AuthAltTypes, []string{})
+}
+
+// GetAuthInfo returns AuthInfo as a map. Flags without a value are mapped to
+// the empty string.
+func (o *V0045Conf) GetAuthInfo() map[string]string {
+	return parseConfParameters(strings.Split(ptr.Deref(o.AuthInfo, ""), ","))
+}
+
+// GetAuthAltParameters returns AuthAltParameters as a map. Flags without a
+// value are mapped to the empty string.
+func (o *V0045Conf) GetAuthAltParameters() map[string]string {
+	return parseConfParameters(strings.Split(ptr.Deref(o.AuthAltParameters, ""), ","))
+}
+
+// GetCredType returns the CredType (e.g. "cred/slurm").
+func (o *V0045Conf) GetCredType() string {
+	return ptr.Deref(o.CredType, "")
+}
+
+// parseConfParameters parses a list of "key=value" or "flag" slurm.conf
+// parameters.
+func parseConfParameters(params []string) map[string]string {
+	out := make(map[string]string)
+	for _, param := range params {
+		param = strings.TrimSpace(param)
+		if param == "" {
+			continue
+		}
+		key, value, _ := strings.Cut(param, "=")
+		out[key] = value
+	}
+	return out
+}
+
+type V0045ConfList struct {
+	Items []V0045Conf
+}
+
+// GetType implements ObjectList.
+func (o *V0045ConfList) GetType() object.ObjectType {
+	return ObjectTypeV0045Conf
+}
+
+// GetItems implements ObjectList.
+func (o *V0045ConfList) GetItems() []object.Object {
+	list := make([]object.Object, len(o.Items))
+	for i, item := range o.Items {
+		list[i] = item.DeepCopyObject()
+	}
+	return list
+}
+
+// AppendItem implements ObjectList.
+func (o *V0045ConfList) AppendItem(object object.Object) {
+	out, ok := object.(*V0045Conf)
+	if ok {
+		utils.RemarshalOrDie(object, out)
+		o.Items = append(o.Items, *out)
+	}
+}
+
+// DeepCopyObjectList implements ObjectList.
+func (o *V0045ConfList) DeepCopyObjectList() object.ObjectList {
+	out := new(V0045ConfList)
+	out.Items = make([]V0045Conf, len(o.Items))
+	for i, item := range o.Items {
+		out.Items[i] = *item.DeepCopy()
+	}
+	return out
+}
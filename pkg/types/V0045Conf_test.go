@@ -0,0 +1,354 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+	"k8s.io/utils/set"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+)
+
+func TestV0045Conf_GetKey(t *testing.T) {
+	type fields struct {
+		V0045Conf api.V0045SlurmConf
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectKey
+	}{
+		{
+			name: "key",
+			fields: fields{
+				V0045Conf: api.V0045SlurmConf{},
+			},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Conf{
+				V0045SlurmConf: tt.fields.V0045Conf,
+			}
+			got := o.GetKey()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Conf_GetType(t *testing.T) {
+	type fields struct {
+		V0045Conf api.V0045SlurmConf
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				V0045Conf: api.V0045SlurmConf{},
+			},
+			want: ObjectTypeV0045Conf,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Conf{
+				V0045SlurmConf: tt.fields.V0045Conf,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Conf_DeepCopyObject(t *testing.T) {
+	type fields struct {
+		V0045Conf api.V0045SlurmConf
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Conf: api.V0045SlurmConf{},
+			},
+			want: &V0045Conf{},
+		},
+		{
+			name: "cluster",
+			fields: fields{
+				V0045Conf: api.V0045SlurmConf{ClusterName: ptr.To("slurm")},
+			},
+			want: &V0045Conf{V0045SlurmConf: api.V0045SlurmConf{ClusterName: ptr.To("slurm")}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Conf{
+				V0045SlurmConf: tt.fields.V0045Conf,
+			}
+			got := o.DeepCopyObject()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Conf_DeepCopy(t *testing.T) {
+	type fields struct {
+		V0045Conf api.V0045SlurmConf
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   *V0045Conf
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Conf: api.V0045SlurmConf{},
+			},
+			want: &V0045Conf{},
+		},
+		{
+			name: "cluster",
+			fields: fields{
+				V0045Conf: api.V0045SlurmConf{ClusterName: ptr.To("slurm")},
+			},
+			want: &V0045Conf{V0045SlurmConf: api.V0045SlurmConf{ClusterName: ptr.To("slurm")}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Conf{
+				V0045SlurmConf: tt.fields.V0045Conf,
+			}
+			got := o.DeepCopy()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Conf_Accessors(t *testing.T) {
+	conf := &V0045Conf{
+		V0045SlurmConf: api.V0045SlurmConf{
+			AuthAltParameters:    ptr.To("jwt_key=/etc/slurm/jwt.key"),
+			AuthAltTypes:         &[]string{"auth/jwt"},
+			AuthInfo:             ptr.To("socket=/run/munge.sock,ttl=60"),
+			AuthType:             ptr.To("auth/munge"),
+			CredType:             ptr.To("cred/munge"),
+			MaxJobCount:          ptr.To[int32](10000),
+			SchedulerParameters:  &[]string{"bf_continue", "bf_interval=30"},
+			SchedulerType:        ptr.To("sched/backfill"),
+			SelectType:           ptr.To("select/cons_tres"),
+			SelectTypeParameters: &[]api.V0045SlurmConfSelectTypeParameters{api.CRCOREMEMORY},
+		},
+		Meta: api.V0045SlurmConfMeta{
+			HashValue:  ptr.To[int32](42),
+			LastUpdate: ptr.To[int64](1700000000),
+		},
+	}
+	require.Equal(t, int32(42), conf.GetHashValue())
+	require.Equal(t, time.Unix(1700000000, 0), conf.GetLastUpdate())
+	require.Equal(t, map[string]string{"bf_continue": "", "bf_interval": "30"}, conf.GetSchedulerParameters())
+	require.Equal(t, "sched/backfill", conf.GetSchedulerType())
+	require.Equal(t, "select/cons_tres", conf.GetSelectType())
+	require.Equal(t, set.New(api.CRCOREMEMORY), conf.GetSelectTypeParametersAsSet())
+	require.Equal(t, int32(10000), conf.GetMaxJobCount())
+	require.Equal(t, "auth/munge", conf.GetAuthType())
+	require.Equal(t, []string{"auth/jwt"}, conf.GetAuthAltTypes())
+	require.Equal(t, map[string]string{"socket": "/run/munge.sock", "ttl": "60"}, conf.GetAuthInfo())
+	require.Equal(t, map[string]string{"jwt_key": "/etc/slurm/jwt.key"}, conf.GetAuthAltParameters())
+	require.Equal(t, "cred/munge", conf.GetCredType())
+
+	empty := &V0045Conf{}
+	require.Equal(t, int32(0), empty.GetHashValue())
+	require.Equal(t, map[string]string{}, empty.GetSchedulerParameters())
+	require.Equal(t, set.New[api.V0045SlurmConfSelectTypeParameters](), empty.GetSelectTypeParametersAsSet())
+	require.Equal(t, []string{}, empty.GetAuthAltTypes())
+	require.Equal(t, map[string]string{}, empty.GetAuthInfo())
+}
+
+func TestV0045ConfList_GetType(t *testing.T) {
+	type fields struct {
+		Items []V0045Conf
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				Items: []V0045Conf{},
+			},
+			want: ObjectTypeV0045Conf,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045ConfList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045ConfList_GetItems(t *testing.T) {
+	type fields struct {
+		Items []V0045Conf
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   []object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045Conf{},
+			},
+			want: []object.Object{},
+		},
+		{
+			name: "items",
+			fields: fields{
+				Items: []V0045Conf{
+					{V0045SlurmConf: api.V0045SlurmConf{}},
+				},
+			},
+			want: []object.Object{
+				&V0045Conf{V0045SlurmConf: api.V0045SlurmConf{}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045ConfList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetItems()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045ConfList_AppendItem(t *testing.T) {
+	type fields struct {
+		Items []V0045Conf
+	}
+	type args struct {
+		object object.Object
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		args       args
+		wantAppend bool
+	}{
+		{
+			name: "nil",
+			fields: fields{
+				Items: []V0045Conf{},
+			},
+			args: args{
+				object: nil,
+			},
+			wantAppend: false,
+		},
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045Conf{},
+			},
+			args: args{
+				object: &V0045Conf{},
+			},
+			wantAppend: true,
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0045Conf{
+					{V0045SlurmConf: api.V0045SlurmConf{}},
+				},
+			},
+			args: args{
+				object: &V0045Conf{},
+			},
+			wantAppend: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045ConfList{
+				Items: tt.fields.Items,
+			}
+			want := len(o.GetItems())
+			if tt.wantAppend {
+				want++
+			}
+			o.AppendItem(tt.args.object)
+			got := len(o.GetItems())
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func TestV0045ConfList_DeepCopyObjectList(t *testing.T) {
+	type fields struct {
+		Items []V0045Conf
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectList
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045Conf{},
+			},
+			want: &V0045ConfList{
+				Items: []V0045Conf{},
+			},
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0045Conf{
+					{V0045SlurmConf: api.V0045SlurmConf{}},
+				},
+			},
+			want: &V0045ConfList{
+				Items: []V0045Conf{
+					{V0045SlurmConf: api.V0045SlurmConf{}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045ConfList{
+				Items: tt.fields.Items,
+			}
+			got := o.DeepCopyObjectList()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
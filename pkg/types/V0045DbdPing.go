@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+const (
+	ObjectTypeV0045DbdPing = "V0045DbdPing"
+)
+
+type V0045DbdPing struct {
+	api.V0045SlurmdbdPing
+}
+
+// GetKey implements Object.
+func (o *V0045DbdPing) GetKey() object.ObjectKey {
+	return object.ObjectKey(o.Hostname)
+}
+
+// GetType implements Object.
+func (o *V0045DbdPing) GetType() object.ObjectType {
+	return ObjectTypeV0045DbdPing
+}
+
+// DeepCopyObject implements Object.
+func (o *V0045DbdPing) DeepCopyObject() object.Object {
+	return o.DeepCopy()
+}
+
+func (o *V0045DbdPing) DeepCopy() *V0045DbdPing {
+	out := new(V0045DbdPing)
+	utils.RemarshalOrDie(o, out)
+	return out
+}
+
+type V0045DbdPingList struct {
+	Items []V0045DbdPing
+}
+
+// GetType implements ObjectList.
+func (o *V0045DbdPingList) GetType() object.ObjectType {
+	return ObjectTypeV0045DbdPing
+}
+
+// GetItems implements ObjectList.
+func (o *V0045DbdPingList) GetItems() []object.Object {
+	list := make([]object.Object, len(o.Items))
+	for i, item := range o.Items {
+		list[i] = item.DeepCopyObject()
+	}
+	return list
+}
+
+// AppendItem implements ObjectList.
+func (o *V0045DbdPingList) AppendItem(object object.Object) {
+	out, ok := object.(*V0045DbdPing)
+	if ok {
+		utils.RemarshalOrDie(object, out)
+		o.Items = append(o.Items, *out)
+	}
+}
+
+// DeepCopyObjectList implements ObjectList.
+func (o *V0045DbdPingList) DeepCopyObjectList() object.ObjectList {
+	out := new(V0045DbdPingList)
+	out.Items = make([]V0045DbdPing, len(o.Items))
+	for i, item := range o.Items {
+		out.Items[i] = *item.DeepCopy()
+	}
+	return out
+}
@@ -0,0 +1,323 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+)
+
+func TestV0045DbdPing_GetKey(t *testing.T) {
+	type fields struct {
+		V0045DbdPing api.V0045SlurmdbdPing
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectKey
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045DbdPing: api.V0045SlurmdbdPing{},
+			},
+			want: "",
+		},
+		{
+			name: "key",
+			fields: fields{
+				V0045DbdPing: api.V0045SlurmdbdPing{Hostname: "slurmdbd-0"},
+			},
+			want: "slurmdbd-0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045DbdPing{
+				V0045SlurmdbdPing: tt.fields.V0045DbdPing,
+			}
+			got := o.GetKey()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045DbdPing_GetType(t *testing.T) {
+	type fields struct {
+		V0045DbdPing api.V0045SlurmdbdPing
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				V0045DbdPing: api.V0045SlurmdbdPing{},
+			},
+			want: ObjectTypeV0045DbdPing,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045DbdPing{
+				V0045SlurmdbdPing: tt.fields.V0045DbdPing,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045DbdPing_DeepCopyObject(t *testing.T) {
+	type fields struct {
+		V0045DbdPing api.V0045SlurmdbdPing
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045DbdPing: api.V0045SlurmdbdPing{},
+			},
+			want: &V0045DbdPing{},
+		},
+		{
+			name: "id",
+			fields: fields{
+				V0045DbdPing: api.V0045SlurmdbdPing{Hostname: "slurmdbd-0"},
+			},
+			want: &V0045DbdPing{api.V0045SlurmdbdPing{Hostname: "slurmdbd-0"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045DbdPing{
+				V0045SlurmdbdPing: tt.fields.V0045DbdPing,
+			}
+			got := o.DeepCopyObject()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045DbdPing_DeepCopy(t *testing.T) {
+	type fields struct {
+		V0045DbdPing api.V0045SlurmdbdPing
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   *V0045DbdPing
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045DbdPing: api.V0045SlurmdbdPing{},
+			},
+			want: &V0045DbdPing{},
+		},
+		{
+			name: "id",
+			fields: fields{
+				V0045DbdPing: api.V0045SlurmdbdPing{Hostname: "slurmdbd-0"},
+			},
+			want: &V0045DbdPing{api.V0045SlurmdbdPing{Hostname: "slurmdbd-0"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045DbdPing{
+				V0045SlurmdbdPing: tt.fields.V0045DbdPing,
+			}
+			got := o.DeepCopy()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045DbdPingList_GetType(t *testing.T) {
+	type fields struct {
+		Items []V0045DbdPing
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				Items: []V0045DbdPing{},
+			},
+			want: ObjectTypeV0045DbdPing,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045DbdPingList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045DbdPingList_GetItems(t *testing.T) {
+	type fields struct {
+		Items []V0045DbdPing
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   []object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045DbdPing{},
+			},
+			want: []object.Object{},
+		},
+		{
+			name: "items",
+			fields: fields{
+				Items: []V0045DbdPing{
+					{V0045SlurmdbdPing: api.V0045SlurmdbdPing{Hostname: "slurmdbd-0"}},
+					{V0045SlurmdbdPing: api.V0045SlurmdbdPing{Hostname: "slurmdbd-1"}},
+				},
+			},
+			want: []object.Object{
+				&V0045DbdPing{api.V0045SlurmdbdPing{Hostname: "slurmdbd-0"}},
+				&V0045DbdPing{api.V0045SlurmdbdPing{Hostname: "slurmdbd-1"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045DbdPingList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetItems()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045DbdPingList_AppendItem(t *testing.T) {
+	type fields struct {
+		Items []V0045DbdPing
+	}
+	type args struct {
+		object object.Object
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		args       args
+		wantAppend bool
+	}{
+		{
+			name: "nil",
+			fields: fields{
+				Items: []V0045DbdPing{},
+			},
+			args: args{
+				object: nil,
+			},
+			wantAppend: false,
+		},
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045DbdPing{},
+			},
+			args: args{
+				object: &V0045DbdPing{},
+			},
+			wantAppend: true,
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0045DbdPing{
+					{V0045SlurmdbdPing: api.V0045SlurmdbdPing{Hostname: "slurmdbd-0"}},
+					{V0045SlurmdbdPing: api.V0045SlurmdbdPing{Hostname: "slurmdbd-1"}},
+				},
+			},
+			args: args{
+				object: &V0045DbdPing{},
+			},
+			wantAppend: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045DbdPingList{
+				Items: tt.fields.Items,
+			}
+			want := len(o.GetItems())
+			if tt.wantAppend {
+				want++
+			}
+			o.AppendItem(tt.args.object)
+			got := len(o.GetItems())
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func TestV0045DbdPingList_DeepCopyObjectList(t *testing.T) {
+	type fields struct {
+		Items []V0045DbdPing
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectList
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045DbdPing{},
+			},
+			want: &V0045DbdPingList{
+				Items: []V0045DbdPing{},
+			},
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0045DbdPing{
+					{V0045SlurmdbdPing: api.V0045SlurmdbdPing{Hostname: "slurmdbd-0"}},
+					{V0045SlurmdbdPing: api.V0045SlurmdbdPing{Hostname: "slurmdbd-1"}},
+				},
+			},
+			want: &V0045DbdPingList{
+				Items: []V0045DbdPing{
+					{api.V0045SlurmdbdPing{Hostname: "slurmdbd-0"}},
+					{api.V0045SlurmdbdPing{Hostname: "slurmdbd-1"}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045DbdPingList{
+				Items: tt.fields.Items,
+			}
+			got := o.DeepCopyObjectList()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
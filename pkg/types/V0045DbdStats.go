@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+const (
+	ObjectTypeV0045DbdStats = "V0045DbdStats"
+)
+
+type V0045DbdStats struct {
+	api.V0045StatsRec
+}
+
+// GetKey implements Object.
+func (o *V0045DbdStats) GetKey() object.ObjectKey {
+	return ""
+}
+
+// GetType implements Object.
+func (o *V0045DbdStats) GetType() object.ObjectType {
+	return ObjectTypeV0045DbdStats
+}
+
+// DeepCopyObject implements Object.
+func (o *V0045DbdStats) DeepCopyObject() object.Object {
+	return o.DeepCopy()
+}
+
+func (o *V0045DbdStats) DeepCopy() *V0045DbdStats {
+	out := new(V0045DbdStats)
+	utils.RemarshalOrDie(o, out)
+	return out
+}
+
+type V0045DbdStatsList struct {
+	Items []V0045DbdStats
+}
+
+// GetType implements ObjectList.
+func (o *V0045DbdStatsList) GetType() object.ObjectType {
+	return ObjectTypeV0045DbdStats
+}
+
+// GetItems implements ObjectList.
+func (o *V0045DbdStatsList) GetItems() []object.Object {
+	list := make([]object.Object, len(o.Items))
+	for i, item := range o.Items {
+		list[i] = item.DeepCopyObject()
+	}
+	return list
+}
+
+// AppendItem implements ObjectList.
+func (o *V0045DbdStatsList) AppendItem(object object.Object) {
+	out, ok := object.(*V0045DbdStats)
+	if ok {
+		utils.RemarshalOrDie(object, out)
+		o.Items = append(o.Items, *out)
+	}
+}
+
+// DeepCopyObjectList implements ObjectList.
+func (o *V0045DbdStatsList) DeepCopyObjectList() object.ObjectList {
+	out := new(V0045DbdStatsList)
+	out.Items = make([]V0045DbdStats, len(o.Items))
+	for i, item := range o.Items {
+		out.Items[i] = *item.DeepCopy()
+	}
+	return out
+}
@@ -0,0 +1,311 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+)
+
+func TestV0045DbdStats_GetKey(t *testing.T) {
+	type fields struct {
+		V0045DbdStats api.V0045StatsRec
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectKey
+	}{
+		{
+			name: "key",
+			fields: fields{
+				V0045DbdStats: api.V0045StatsRec{},
+			},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045DbdStats{
+				V0045StatsRec: tt.fields.V0045DbdStats,
+			}
+			got := o.GetKey()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045DbdStats_GetType(t *testing.T) {
+	type fields struct {
+		V0045DbdStats api.V0045StatsRec
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				V0045DbdStats: api.V0045StatsRec{},
+			},
+			want: ObjectTypeV0045DbdStats,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045DbdStats{
+				V0045StatsRec: tt.fields.V0045DbdStats,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045DbdStats_DeepCopyObject(t *testing.T) {
+	type fields struct {
+		V0045DbdStats api.V0045StatsRec
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045DbdStats: api.V0045StatsRec{},
+			},
+			want: &V0045DbdStats{},
+		},
+		{
+			name: "id",
+			fields: fields{
+				V0045DbdStats: api.V0045StatsRec{},
+			},
+			want: &V0045DbdStats{api.V0045StatsRec{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045DbdStats{
+				V0045StatsRec: tt.fields.V0045DbdStats,
+			}
+			got := o.DeepCopyObject()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045DbdStats_DeepCopy(t *testing.T) {
+	type fields struct {
+		V0045DbdStats api.V0045StatsRec
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   *V0045DbdStats
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045DbdStats: api.V0045StatsRec{},
+			},
+			want: &V0045DbdStats{},
+		},
+		{
+			name: "id",
+			fields: fields{
+				V0045DbdStats: api.V0045StatsRec{},
+			},
+			want: &V0045DbdStats{api.V0045StatsRec{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045DbdStats{
+				V0045StatsRec: tt.fields.V0045DbdStats,
+			}
+			got := o.DeepCopy()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045DbdStatsList_GetType(t *testing.T) {
+	type fields struct {
+		Items []V0045DbdStats
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				Items: []V0045DbdStats{},
+			},
+			want: ObjectTypeV0045DbdStats,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045DbdStatsList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045DbdStatsList_GetItems(t *testing.T) {
+	type fields struct {
+		Items []V0045DbdStats
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   []object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045DbdStats{},
+			},
+			want: []object.Object{},
+		},
+		{
+			name: "items",
+			fields: fields{
+				Items: []V0045DbdStats{
+					{V0045StatsRec: api.V0045StatsRec{}},
+				},
+			},
+			want: []object.Object{
+				&V0045DbdStats{api.V0045StatsRec{}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045DbdStatsList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetItems()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045DbdStatsList_AppendItem(t *testing.T) {
+	type fields struct {
+		Items []V0045DbdStats
+	}
+	type args struct {
+		object object.Object
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		args       args
+		wantAppend bool
+	}{
+		{
+			name: "nil",
+			fields: fields{
+				Items: []V0045DbdStats{},
+			},
+			args: args{
+				object: nil,
+			},
+			wantAppend: false,
+		},
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045DbdStats{},
+			},
+			args: args{
+				object: &V0045DbdStats{},
+			},
+			wantAppend: true,
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0045DbdStats{
+					{V0045StatsRec: api.V0045StatsRec{}},
+				},
+			},
+			args: args{
+				object: &V0045DbdStats{},
+			},
+			wantAppend: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045DbdStatsList{
+				Items: tt.fields.Items,
+			}
+			want := len(o.GetItems())
+			if tt.wantAppend {
+				want++
+			}
+			o.AppendItem(tt.args.object)
+			got := len(o.GetItems())
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func TestV0045DbdStatsList_DeepCopyObjectList(t *testing.T) {
+	type fields struct {
+		Items []V0045DbdStats
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectList
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045DbdStats{},
+			},
+			want: &V0045DbdStatsList{
+				Items: []V0045DbdStats{},
+			},
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0045DbdStats{
+					{V0045StatsRec: api.V0045StatsRec{}},
+				},
+			},
+			want: &V0045DbdStatsList{
+				Items: []V0045DbdStats{
+					{api.V0045StatsRec{}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045DbdStatsList{
+				Items: tt.fields.Items,
+			}
+			got := o.DeepCopyObjectList()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
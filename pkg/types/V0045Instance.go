@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"slices"
+	"time"
+
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+const (
+	ObjectTypeV0045Instance = "V0045Instance"
+)
+
+type V0045Instance struct {
+	api.V0045Instance
+}
+
+// GetKey implements Object.
+func (o *V0045Instance) GetKey() object.ObjectKey {
+	return object.ObjectKey(ptr.Deref(o.InstanceId, ""))
+}
+
+// GetType implements Object.
+func (o *V0045Instance) GetType() object.ObjectType {
+	return ObjectTypeV0045Instance
+}
+
+// DeepCopyObject implements Object.
+func (o *V0045Instance) DeepCopyObject() object.Object {
+	return o.DeepCopy()
+}
+
+func (o *V0045Instance) DeepCopy() *V0045Instance {
+	out := new(V0045Instance)
+	utils.RemarshalOrDie(o, out)
+	return out
+}
+
+// GetStartTime returns when the instance started, or the zero time if unknown.
+func (o *V0045Instance) GetStartTime() time.Time {
+	if o.Time == nil {
+		return time.Time{}
+	}
+	if start := ptr.Deref(o.Time.TimeStart, 0); start > 0 {
+		return time.Unix(start, 0)
+	}
+	return time.Time{}
+}
+
+// GetEndTime returns when the instance ended, or the zero time if it is still
+// running.
+func (o *V0045Instance) GetEndTime() time.Time {
+	if o.Time == nil {
+		return time.Time{}
+	}
+	if end := ptr.Deref(o.Time.TimeEnd, 0); end > 0 {
+		return time.Unix(end, 0)
+	}
+	return time.Time{}
+}
+
+type V0045InstanceList struct {
+	Items []V0045Instance
+}
+
+// GetType implements ObjectList.
+func (o *V0045InstanceList) GetType() object.ObjectType {
+	return ObjectTypeV0045Instance
+}
+
+// GetItems implements ObjectList.
+func (o *V0045InstanceList) GetItems() []object.Object {
+	list := make([]object.Object, len(o.Items))
+	for i, item := range o.Items {
+		list[i] = item.DeepCopyObject()
+	}
+	return list
+}
+
+// AppendItem implements ObjectList.
+func (o *V0045InstanceList) AppendItem(object object.Object) {
+	out, ok := object.(*V0045Instance)
+	if ok {
+		utils.RemarshalOrDie(object, out)
+		o.Items = append(o.Items, *out)
+	}
+}
+
+// DeepCopyObjectList implements ObjectList.
+func (o *V0045InstanceList) DeepCopyObjectList() object.ObjectList {
+	out := new(V0045InstanceList)
+	out.Items = make([]V0045Instance, len(o.Items))
+	for i, item := range o.Items {
+		out.Items[i] = *item.DeepCopy()
+	}
+	return out
+}
+
+// V0045NodeInstances pairs a node with the cloud instances that ran as it.
+type V0045NodeInstances struct {
+	Node      V0045Node
+	Instances []V0045Instance
+}
+
+// JoinNodes matches the instances to nodes by node name. It returns one entry
+// per node, in the order of nodes, with its instances ordered by start time,
+// and the instances that match none of the nodes.
+func (o *V0045InstanceList) JoinNodes(nodes *V0045NodeList) ([]V0045NodeInstances, []V0045Instance) {
+	byNode := make(map[string][]V0045Instance, len(nodes.Items))
+	for _, node := range nodes.Items {
+		byNode[string(node.GetKey())] = nil
+	}
+
+	unmatched := []V0045Instance{}
+	for _, item := range o.Items {
+		name := ptr.Deref(item.NodeName, "")
+		instances, ok := byNode[name]
+		if !ok {
+			unmatched = append(unmatched, *item.DeepCopy())
+			continue
+		}
+		byNode[name] = append(instances, *item.DeepCopy())
+	}
+
+	out := make([]V0045NodeInstances, len(nodes.Items))
+	for i, node := range nodes.Items {
+		instances := byNode[string(node.GetKey())]
+		slices.SortStableFunc(instances, func(a, b V0045Instance) int {
+			return a.GetStartTime().Compare(b.GetStartTime())
+		})
+		out[i] = V0045NodeInstances{
+			Node:      *node.DeepCopy(),
+			Instances: instances,
+		}
+	}
+	return out, unmatched
+}
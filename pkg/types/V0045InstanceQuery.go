@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"time"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+)
+
+// V0045InstanceQuery builds the query parameters of a slurmdbd cloud instance
+// request.
+type V0045InstanceQuery struct {
+	StartTime     time.Time
+	EndTime       time.Time
+	Clusters      []string
+	InstanceIds   []string
+	InstanceTypes []string
+	Nodes         []string
+}
+
+// NewV0045InstanceQuery returns an empty query.
+func NewV0045InstanceQuery() *V0045InstanceQuery {
+	return &V0045InstanceQuery{}
+}
+
+// Between restricts the query to instances running within [start, end).
+func (q *V0045InstanceQuery) Between(start, end time.Time) *V0045InstanceQuery {
+	q.StartTime = start
+	q.EndTime = end
+	return q
+}
+
+// WithClusters restricts the query to instances of any of clusters.
+func (q *V0045InstanceQuery) WithClusters(clusters ...string) *V0045InstanceQuery {
+	q.Clusters = append(q.Clusters, clusters...)
+	return q
+}
+
+// WithInstanceIds restricts the query to any of the cloud instance ids.
+func (q *V0045InstanceQuery) WithInstanceIds(ids ...string) *V0045InstanceQuery {
+	q.InstanceIds = append(q.InstanceIds, ids...)
+	return q
+}
+
+// WithInstanceTypes restricts the query to instances of any of the cloud
+// instance types.
+func (q *V0045InstanceQuery) WithInstanceTypes(instanceTypes ...string) *V0045InstanceQuery {
+	q.InstanceTypes = append(q.InstanceTypes, instanceTypes...)
+	return q
+}
+
+// WithNodes restricts the query to instances that ran as any of nodes, each a
+// node name or hostlist expression (e.g. "node[0-9]").
+func (q *V0045InstanceQuery) WithNodes(nodes ...string) *V0045InstanceQuery {
+	q.Nodes = append(q.Nodes, nodes...)
+	return q
+}
+
+// DeepCopy returns a copy of the query.
+func (q *V0045InstanceQuery) DeepCopy() *V0045InstanceQuery {
+	out := *q
+	out.Clusters = append([]string(nil), q.Clusters...)
+	out.InstanceIds = append([]string(nil), q.InstanceIds...)
+	out.InstanceTypes = append([]string(nil), q.InstanceTypes...)
+	out.Nodes = append([]string(nil), q.Nodes...)
+	return &out
+}
+
+// Params returns the query as SlurmdbV0045GetInstancesParams.
+func (q *V0045InstanceQuery) Params() *api.SlurmdbV0045GetInstancesParams {
+	return &api.SlurmdbV0045GetInstancesParams{
+		TimeStart:    formatQueryTime(q.StartTime),
+		TimeEnd:      formatQueryTime(q.EndTime),
+		Cluster:      formatQueryList(q.Clusters),
+		InstanceId:   formatQueryList(q.InstanceIds),
+		InstanceType: formatQueryList(q.InstanceTypes),
+		NodeList:     formatQueryList(q.Nodes),
+	}
+}
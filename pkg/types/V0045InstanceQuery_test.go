@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+)
+
+func TestV0045InstanceQuery_Params(t *testing.T) {
+	tests := []struct {
+		name  string
+		query *V0045InstanceQuery
+		want  *api.SlurmdbV0045GetInstancesParams
+	}{
+		{
+			name:  "empty",
+			query: NewV0045InstanceQuery(),
+			want:  &api.SlurmdbV0045GetInstancesParams{},
+		},
+		{
+			name: "all filters",
+			query: NewV0045InstanceQuery().
+				Between(time.Unix(100, 0), time.Unix(200, 0)).
+				WithClusters("linux").
+				WithInstanceIds("i-1", "i-2").
+				WithInstanceTypes("c5.large").
+				WithNodes("node[0-9]"),
+			want: &api.SlurmdbV0045GetInstancesParams{
+				TimeStart:    ptr.To("100"),
+				TimeEnd:      ptr.To("200"),
+				Cluster:      ptr.To("linux"),
+				InstanceId:   ptr.To("i-1,i-2"),
+				InstanceType: ptr.To("c5.large"),
+				NodeList:     ptr.To("node[0-9]"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.query.Params()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045InstanceQuery_DeepCopy(t *testing.T) {
+	query := NewV0045InstanceQuery().WithNodes("node-0")
+	out := query.DeepCopy()
+	require.Equal(t, query, out)
+
+	out.WithNodes("node-1")
+	require.Equal(t, []string{"node-0"}, query.Nodes)
+}
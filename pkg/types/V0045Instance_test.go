@@ -0,0 +1,451 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+func TestV0045Instance_GetKey(t *testing.T) {
+	type fields struct {
+		V0045Instance api.V0045Instance
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectKey
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Instance: api.V0045Instance{},
+			},
+			want: "",
+		},
+		{
+			name: "key",
+			fields: fields{
+				V0045Instance: api.V0045Instance{InstanceId: ptr.To("i-1")},
+			},
+			want: "i-1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Instance{
+				V0045Instance: tt.fields.V0045Instance,
+			}
+			got := o.GetKey()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Instance_GetType(t *testing.T) {
+	type fields struct {
+		V0045Instance api.V0045Instance
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				V0045Instance: api.V0045Instance{},
+			},
+			want: ObjectTypeV0045Instance,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Instance{
+				V0045Instance: tt.fields.V0045Instance,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Instance_DeepCopyObject(t *testing.T) {
+	type fields struct {
+		V0045Instance api.V0045Instance
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Instance: api.V0045Instance{},
+			},
+			want: &V0045Instance{},
+		},
+		{
+			name: "id",
+			fields: fields{
+				V0045Instance: api.V0045Instance{InstanceId: ptr.To("i-1")},
+			},
+			want: &V0045Instance{api.V0045Instance{InstanceId: ptr.To("i-1")}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Instance{
+				V0045Instance: tt.fields.V0045Instance,
+			}
+			got := o.DeepCopyObject()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Instance_DeepCopy(t *testing.T) {
+	type fields struct {
+		V0045Instance api.V0045Instance
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   *V0045Instance
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Instance: api.V0045Instance{},
+			},
+			want: &V0045Instance{},
+		},
+		{
+			name: "id",
+			fields: fields{
+				V0045Instance: api.V0045Instance{InstanceId: ptr.To("i-1")},
+			},
+			want: &V0045Instance{api.V0045Instance{InstanceId: ptr.To("i-1")}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Instance{
+				V0045Instance: tt.fields.V0045Instance,
+			}
+			got := o.DeepCopy()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045InstanceList_GetType(t *testing.T) {
+	type fields struct {
+		Items []V0045Instance
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				Items: []V0045Instance{},
+			},
+			want: ObjectTypeV0045Instance,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045InstanceList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045InstanceList_GetItems(t *testing.T) {
+	type fields struct {
+		Items []V0045Instance
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   []object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045Instance{},
+			},
+			want: []object.Object{},
+		},
+		{
+			name: "items",
+			fields: fields{
+				Items: []V0045Instance{
+					{V0045Instance: api.V0045Instance{InstanceId: ptr.To("i-1")}},
+					{V0045Instance: api.V0045Instance{InstanceId: ptr.To("i-2")}},
+				},
+			},
+			want: []object.Object{
+				&V0045Instance{api.V0045Instance{InstanceId: ptr.To("i-1")}},
+				&V0045Instance{api.V0045Instance{InstanceId: ptr.To("i-2")}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045InstanceList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetItems()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045InstanceList_AppendItem(t *testing.T) {
+	type fields struct {
+		Items []V0045Instance
+	}
+	type args struct {
+		object object.Object
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		args       args
+		wantAppend bool
+	}{
+		{
+			name: "nil",
+			fields: fields{
+				Items: []V0045Instance{},
+			},
+			args: args{
+				object: nil,
+			},
+			wantAppend: false,
+		},
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045Instance{},
+			},
+			args: args{
+				object: &V0045Instance{},
+			},
+			wantAppend: true,
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0045Instance{
+					{V0045Instance: api.V0045Instance{InstanceId: ptr.To("i-1")}},
+					{V0045Instance: api.V0045Instance{InstanceId: ptr.To("i-2")}},
+				},
+			},
+			args: args{
+				object: &V0045Instance{},
+			},
+			wantAppend: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045InstanceList{
+				Items: tt.fields.Items,
+			}
+			want := len(o.GetItems())
+			if tt.wantAppend {
+				want++
+			}
+			o.AppendItem(tt.args.object)
+			got := len(o.GetItems())
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func TestV0045InstanceList_DeepCopyObjectList(t *testing.T) {
+	type fields struct {
+		Items []V0045Instance
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectList
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045Instance{},
+			},
+			want: &V0045InstanceList{
+				Items: []V0045Instance{},
+			},
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0045Instance{
+					{V0045Instance: api.V0045Instance{InstanceId: ptr.To("i-1")}},
+					{V0045Instance: api.V0045Instance{InstanceId: ptr.To("i-2")}},
+				},
+			},
+			want: &V0045InstanceList{
+				Items: []V0045Instance{
+					{api.V0045Instance{InstanceId: ptr.To("i-1")}},
+					{api.V0045Instance{InstanceId: ptr.To("i-2")}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045InstanceList{
+				Items: tt.fields.Items,
+			}
+			got := o.DeepCopyObjectList()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func newTestInstance(id, node string, start, end int64) V0045Instance {
+	out := V0045Instance{}
+	utils.RemarshalOrDie(map[string]any{
+		"instance_id": id,
+		"node_name":   node,
+		"time": map[string]any{
+			"time_start": start,
+			"time_end":   end,
+		},
+	}, &out)
+	return out
+}
+
+func TestV0045Instance_GetStartTime(t *testing.T) {
+	tests := []struct {
+		name     string
+		instance V0045Instance
+		want     time.Time
+	}{
+		{
+			name:     "empty",
+			instance: V0045Instance{},
+			want:     time.Time{},
+		},
+		{
+			name:     "unset",
+			instance: newTestInstance("i-1", "node-0", 0, 0),
+			want:     time.Time{},
+		},
+		{
+			name:     "set",
+			instance: newTestInstance("i-1", "node-0", 100, 0),
+			want:     time.Unix(100, 0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.instance.GetStartTime()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Instance_GetEndTime(t *testing.T) {
+	tests := []struct {
+		name     string
+		instance V0045Instance
+		want     time.Time
+	}{
+		{
+			name:     "empty",
+			instance: V0045Instance{},
+			want:     time.Time{},
+		},
+		{
+			name:     "running",
+			instance: newTestInstance("i-1", "node-0", 100, 0),
+			want:     time.Time{},
+		},
+		{
+			name:     "ended",
+			instance: newTestInstance("i-1", "node-0", 100, 200),
+			want:     time.Unix(200, 0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.instance.GetEndTime()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045InstanceList_JoinNodes(t *testing.T) {
+	node0 := V0045Node{api.V0045Node{Name: ptr.To("node-0")}}
+	node1 := V0045Node{api.V0045Node{Name: ptr.To("node-1")}}
+	tests := []struct {
+		name          string
+		instances     []V0045Instance
+		nodes         []V0045Node
+		want          []V0045NodeInstances
+		wantUnmatched []V0045Instance
+	}{
+		{
+			name:          "empty",
+			instances:     []V0045Instance{},
+			nodes:         []V0045Node{},
+			want:          []V0045NodeInstances{},
+			wantUnmatched: []V0045Instance{},
+		},
+		{
+			name: "join",
+			instances: []V0045Instance{
+				newTestInstance("i-2", "node-0", 200, 300),
+				newTestInstance("i-1", "node-0", 100, 200),
+				newTestInstance("i-3", "node-2", 100, 0),
+			},
+			nodes: []V0045Node{node0, node1},
+			want: []V0045NodeInstances{
+				{
+					Node: node0,
+					Instances: []V0045Instance{
+						newTestInstance("i-1", "node-0", 100, 200),
+						newTestInstance("i-2", "node-0", 200, 300),
+					},
+				},
+				{
+					Node: node1,
+				},
+			},
+			wantUnmatched: []V0045Instance{
+				newTestInstance("i-3", "node-2", 100, 0),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := &V0045InstanceList{Items: tt.instances}
+			got, unmatched := list.JoinNodes(&V0045NodeList{Items: tt.nodes})
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.wantUnmatched, unmatched)
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 
 	api "github.com/SlinkyProject/slurm-client/api/v0045"
 	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
 )
 
 func TestV0045JobInfo_GetKey(t *testing.T) {
@@ -194,6 +195,50 @@ func TestV0045JobInfo_GetStateAsSet(t *testing.T) {
 	}
 }
 
+func TestV0045JobInfo_GetAllocatedNodes(t *testing.T) {
+	type fields struct {
+		V0045JobInfo api.V0045JobInfo
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   []string
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045JobInfo: api.V0045JobInfo{},
+			},
+			want: []string{},
+		},
+		{
+			name: "allocated",
+			fields: fields{
+				V0045JobInfo: func() api.V0045JobInfo {
+					job := api.V0045JobInfo{JobResources: &api.V0045JobRes{}}
+					utils.RemarshalOrDie(map[string]any{
+						"allocation": []map[string]any{
+							{"name": "node-0"},
+							{"name": "node-1"},
+						},
+					}, &job.JobResources.Nodes)
+					return job
+				}(),
+			},
+			want: []string{"node-0", "node-1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045JobInfo{
+				V0045JobInfo: tt.fields.V0045JobInfo,
+			}
+			got := o.GetAllocatedNodes()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestV0045JobInfoList_GetType(t *testing.T) {
 	type fields struct {
 		Items []V0045JobInfo
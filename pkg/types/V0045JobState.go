@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+
+	"k8s.io/utils/ptr"
+	"k8s.io/utils/set"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+const (
+	ObjectTypeV0045JobState = "V0045JobState"
+)
+
+// V0045JobState is the lightweight view of a job, as returned by /jobs/state.
+type V0045JobState struct {
+	JobId    *int32                      `json:"job_id,omitempty"`
+	JobState *[]api.V0045JobInfoJobState `json:"job_state,omitempty"`
+}
+
+// GetKey implements Object.
+func (o *V0045JobState) GetKey() object.ObjectKey {
+	jobId := ptr.Deref(o.JobId, 0)
+	return object.ObjectKey(fmt.Sprintf("%d", jobId))
+}
+
+// GetType implements Object.
+func (o *V0045JobState) GetType() object.ObjectType {
+	return ObjectTypeV0045JobState
+}
+
+// DeepCopyObject implements Object.
+func (o *V0045JobState) DeepCopyObject() object.Object {
+	return o.DeepCopy()
+}
+
+func (o *V0045JobState) DeepCopy() *V0045JobState {
+	out := new(V0045JobState)
+	utils.RemarshalOrDie(o, out)
+	return out
+}
+
+func (o *V0045JobState) GetStateAsSet() set.Set[api.V0045JobInfoJobState] {
+	out := make(set.Set[api.V0045JobInfoJobState])
+	states := ptr.Deref(o.JobState, []api.V0045JobInfoJobState{})
+	for _, s := range states {
+		out.Insert(s)
+	}
+	return out
+}
+
+type V0045JobStateList struct {
+	Items []V0045JobState
+}
+
+// GetType implements ObjectList.
+func (o *V0045JobStateList) GetType() object.ObjectType {
+	return ObjectTypeV0045JobState
+}
+
+// GetItems implements ObjectList.
+func (o *V0045JobStateList) GetItems() []object.Object {
+	list := make([]object.Object, len(o.Items))
+	for i, item := range o.Items {
+		list[i] = item.DeepCopyObject()
+	}
+	return list
+}
+
+// AppendItem implements ObjectList.
+func (o *V0045JobStateList) AppendItem(object object.Object) {
+	out, ok := object.(*V0045JobState)
+	if ok {
+		utils.RemarshalOrDie(object, out)
+		o.Items = append(o.Items, *out)
+	}
+}
+
+// DeepCopyObjectList implements ObjectList.
+func (o *V0045JobStateList) DeepCopyObjectList() object.ObjectList {
+	out := new(V0045JobStateList)
+	out.Items = make([]V0045JobState, len(o.Items))
+	for i, item := range o.Items {
+		out.Items[i] = *item.DeepCopy()
+	}
+	return out
+}
@@ -0,0 +1,368 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+	"k8s.io/utils/set"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+)
+
+func TestV0045JobState_GetKey(t *testing.T) {
+	type fields struct {
+		JobId    *int32
+		JobState *[]api.V0045JobInfoJobState
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectKey
+	}{
+		{
+			name:   "empty",
+			fields: fields{},
+			want:   "0",
+		},
+		{
+			name: "key",
+			fields: fields{
+				JobId: ptr.To[int32](1),
+			},
+			want: "1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045JobState{
+				JobId:    tt.fields.JobId,
+				JobState: tt.fields.JobState,
+			}
+			got := o.GetKey()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045JobState_GetType(t *testing.T) {
+	type fields struct {
+		JobId    *int32
+		JobState *[]api.V0045JobInfoJobState
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name:   "type",
+			fields: fields{},
+			want:   ObjectTypeV0045JobState,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045JobState{
+				JobId:    tt.fields.JobId,
+				JobState: tt.fields.JobState,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045JobState_DeepCopyObject(t *testing.T) {
+	type fields struct {
+		JobId    *int32
+		JobState *[]api.V0045JobInfoJobState
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.Object
+	}{
+		{
+			name:   "empty",
+			fields: fields{},
+			want:   &V0045JobState{},
+		},
+		{
+			name: "id",
+			fields: fields{
+				JobId: ptr.To[int32](1),
+			},
+			want: &V0045JobState{JobId: ptr.To[int32](1)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045JobState{
+				JobId:    tt.fields.JobId,
+				JobState: tt.fields.JobState,
+			}
+			got := o.DeepCopyObject()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045JobState_DeepCopy(t *testing.T) {
+	type fields struct {
+		JobId    *int32
+		JobState *[]api.V0045JobInfoJobState
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   *V0045JobState
+	}{
+		{
+			name:   "empty",
+			fields: fields{},
+			want:   &V0045JobState{},
+		},
+		{
+			name: "id",
+			fields: fields{
+				JobId: ptr.To[int32](1),
+			},
+			want: &V0045JobState{JobId: ptr.To[int32](1)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045JobState{
+				JobId:    tt.fields.JobId,
+				JobState: tt.fields.JobState,
+			}
+			got := o.DeepCopy()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045JobState_GetStateAsSet(t *testing.T) {
+	type fields struct {
+		JobId    *int32
+		JobState *[]api.V0045JobInfoJobState
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   set.Set[api.V0045JobInfoJobState]
+	}{
+		{
+			name:   "empty",
+			fields: fields{},
+			want:   set.New[api.V0045JobInfoJobState](),
+		},
+		{
+			name: "single",
+			fields: fields{
+				JobState: ptr.To([]api.V0045JobInfoJobState{api.V0045JobInfoJobStatePENDING}),
+			},
+			want: set.New(api.V0045JobInfoJobStatePENDING),
+		},
+		{
+			name: "multiple",
+			fields: fields{
+				JobState: ptr.To([]api.V0045JobInfoJobState{api.V0045JobInfoJobStateRUNNING, api.V0045JobInfoJobStateREQUEUEFED}),
+			},
+			want: set.New(api.V0045JobInfoJobStateRUNNING, api.V0045JobInfoJobStateREQUEUEFED),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045JobState{
+				JobId:    tt.fields.JobId,
+				JobState: tt.fields.JobState,
+			}
+			if got := o.GetStateAsSet(); !tt.want.Equal(got) {
+				t.Errorf("V0045JobState.GetStateAsSet() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestV0045JobStateList_GetType(t *testing.T) {
+	type fields struct {
+		Items []V0045JobState
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				Items: []V0045JobState{},
+			},
+			want: ObjectTypeV0045JobState,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045JobStateList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045JobStateList_GetItems(t *testing.T) {
+	type fields struct {
+		Items []V0045JobState
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   []object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045JobState{},
+			},
+			want: []object.Object{},
+		},
+		{
+			name: "items",
+			fields: fields{
+				Items: []V0045JobState{
+					{JobId: ptr.To[int32](1)},
+					{JobId: ptr.To[int32](2)},
+				},
+			},
+			want: []object.Object{
+				&V0045JobState{JobId: ptr.To[int32](1)},
+				&V0045JobState{JobId: ptr.To[int32](2)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045JobStateList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetItems()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045JobStateList_AppendItem(t *testing.T) {
+	type fields struct {
+		Items []V0045JobState
+	}
+	type args struct {
+		object object.Object
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		args       args
+		wantAppend bool
+	}{
+		{
+			name: "nil",
+			fields: fields{
+				Items: []V0045JobState{},
+			},
+			args: args{
+				object: nil,
+			},
+			wantAppend: false,
+		},
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045JobState{},
+			},
+			args: args{
+				object: &V0045JobState{},
+			},
+			wantAppend: true,
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0045JobState{
+					{JobId: ptr.To[int32](1)},
+					{JobId: ptr.To[int32](2)},
+				},
+			},
+			args: args{
+				object: &V0045JobState{},
+			},
+			wantAppend: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045JobStateList{
+				Items: tt.fields.Items,
+			}
+			want := len(o.GetItems())
+			if tt.wantAppend {
+				want++
+			}
+			o.AppendItem(tt.args.object)
+			got := len(o.GetItems())
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func TestV0045JobStateList_DeepCopyObjectList(t *testing.T) {
+	type fields struct {
+		Items []V0045JobState
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectList
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045JobState{},
+			},
+			want: &V0045JobStateList{
+				Items: []V0045JobState{},
+			},
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0045JobState{
+					{JobId: ptr.To[int32](1)},
+					{JobId: ptr.To[int32](2)},
+				},
+			},
+			want: &V0045JobStateList{
+				Items: []V0045JobState{
+					{JobId: ptr.To[int32](1)},
+					{JobId: ptr.To[int32](2)},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045JobStateList{
+				Items: tt.fields.Items,
+			}
+			got := o.DeepCopyObjectList()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+const (
+	ObjectTypeV0045License = "V0045License"
+)
+
+type V0045License struct {
+	api.V0045License
+}
+
+// GetKey implements Object.
+func (o *V0045License) GetKey() object.ObjectKey {
+	return object.ObjectKey(ptr.Deref(o.LicenseName, ""))
+}
+
+// GetType implements Object.
+func (o *V0045License) GetType() object.ObjectType {
+	return ObjectTypeV0045License
+}
+
+// DeepCopyObject implements Object.
+func (o *V0045License) DeepCopyObject() object.Object {
+	return o.DeepCopy()
+}
+
+func (o *V0045License) DeepCopy() *V0045License {
+	out := new(V0045License)
+	utils.RemarshalOrDie(o, out)
+	return out
+}
+
+type V0045LicenseList struct {
+	Items []V0045License
+}
+
+// GetType implements ObjectList.
+func (o *V0045LicenseList) GetType() object.ObjectType {
+	return ObjectTypeV0045License
+}
+
+// GetItems implements ObjectList.
+func (o *V0045LicenseList) GetItems() []object.Object {
+	list := make([]object.Object, len(o.Items))
+	for i, item := range o.Items {
+		list[i] = item.DeepCopyObject()
+	}
+	return list
+}
+
+// AppendItem implements ObjectList.
+func (o *V0045LicenseList) AppendItem(object object.Object) {
+	out, ok := object.(*V0045License)
+	if ok {
+		utils.RemarshalOrDie(object, out)
+		o.Items = append(o.Items, *out)
+	}
+}
+
+// DeepCopyObjectList implements ObjectList.
+func (o *V0045LicenseList) DeepCopyObjectList() object.ObjectList {
+	out := new(V0045LicenseList)
+	out.Items = make([]V0045License, len(o.Items))
+	for i, item := range o.Items {
+		out.Items[i] = *item.DeepCopy()
+	}
+	return out
+}
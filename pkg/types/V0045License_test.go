@@ -0,0 +1,324 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+)
+
+func TestV0045License_GetKey(t *testing.T) {
+	type fields struct {
+		V0045License api.V0045License
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectKey
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045License: api.V0045License{},
+			},
+			want: "",
+		},
+		{
+			name: "key",
+			fields: fields{
+				V0045License: api.V0045License{LicenseName: ptr.To("test_0")},
+			},
+			want: "test_0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045License{
+				V0045License: tt.fields.V0045License,
+			}
+			got := o.GetKey()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045License_GetType(t *testing.T) {
+	type fields struct {
+		V0045License api.V0045License
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				V0045License: api.V0045License{},
+			},
+			want: ObjectTypeV0045License,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045License{
+				V0045License: tt.fields.V0045License,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045License_DeepCopyObject(t *testing.T) {
+	type fields struct {
+		V0045License api.V0045License
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045License: api.V0045License{},
+			},
+			want: &V0045License{},
+		},
+		{
+			name: "id",
+			fields: fields{
+				V0045License: api.V0045License{LicenseName: ptr.To("test_0")},
+			},
+			want: &V0045License{api.V0045License{LicenseName: ptr.To("test_0")}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045License{
+				V0045License: tt.fields.V0045License,
+			}
+			got := o.DeepCopyObject()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045License_DeepCopy(t *testing.T) {
+	type fields struct {
+		V0045License api.V0045License
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   *V0045License
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045License: api.V0045License{},
+			},
+			want: &V0045License{},
+		},
+		{
+			name: "id",
+			fields: fields{
+				V0045License: api.V0045License{LicenseName: ptr.To("test_0")},
+			},
+			want: &V0045License{api.V0045License{LicenseName: ptr.To("test_0")}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045License{
+				V0045License: tt.fields.V0045License,
+			}
+			got := o.DeepCopy()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045LicenseList_GetType(t *testing.T) {
+	type fields struct {
+		Items []V0045License
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				Items: []V0045License{},
+			},
+			want: ObjectTypeV0045License,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045LicenseList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045LicenseList_GetItems(t *testing.T) {
+	type fields struct {
+		Items []V0045License
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   []object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045License{},
+			},
+			want: []object.Object{},
+		},
+		{
+			name: "items",
+			fields: fields{
+				Items: []V0045License{
+					{V0045License: api.V0045License{LicenseName: ptr.To("test_0")}},
+					{V0045License: api.V0045License{LicenseName: ptr.To("test_1")}},
+				},
+			},
+			want: []object.Object{
+				&V0045License{api.V0045License{LicenseName: ptr.To("test_0")}},
+				&V0045License{api.V0045License{LicenseName: ptr.To("test_1")}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045LicenseList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetItems()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045LicenseList_AppendItem(t *testing.T) {
+	type fields struct {
+		Items []V0045License
+	}
+	type args struct {
+		object object.Object
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		args       args
+		wantAppend bool
+	}{
+		{
+			name: "nil",
+			fields: fields{
+				Items: []V0045License{},
+			},
+			args: args{
+				object: nil,
+			},
+			wantAppend: false,
+		},
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045License{},
+			},
+			args: args{
+				object: &V0045License{},
+			},
+			wantAppend: true,
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0045License{
+					{V0045License: api.V0045License{LicenseName: ptr.To("test_0")}},
+					{V0045License: api.V0045License{LicenseName: ptr.To("test_1")}},
+				},
+			},
+			args: args{
+				object: &V0045License{},
+			},
+			wantAppend: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045LicenseList{
+				Items: tt.fields.Items,
+			}
+			want := len(o.GetItems())
+			if tt.wantAppend {
+				want++
+			}
+			o.AppendItem(tt.args.object)
+			got := len(o.GetItems())
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func TestV0045LicenseList_DeepCopyObjectList(t *testing.T) {
+	type fields struct {
+		Items []V0045License
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectList
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045License{},
+			},
+			want: &V0045LicenseList{
+				Items: []V0045License{},
+			},
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0045License{
+					{V0045License: api.V0045License{LicenseName: ptr.To("test_0")}},
+					{V0045License: api.V0045License{LicenseName: ptr.To("test_1")}},
+				},
+			},
+			want: &V0045LicenseList{
+				Items: []V0045License{
+					{api.V0045License{LicenseName: ptr.To("test_0")}},
+					{api.V0045License{LicenseName: ptr.To("test_1")}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045LicenseList{
+				Items: tt.fields.Items,
+			}
+			got := o.DeepCopyObjectList()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
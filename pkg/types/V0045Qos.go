@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"k8s.io/utils/ptr"
+	"k8s.io/utils/set"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+const (
+	ObjectTypeV0045Qos = "V0045Qos"
+)
+
+type V0045Qos struct {
+	api.V0045Qos
+}
+
+// GetKey implements Object.
+func (o *V0045Qos) GetKey() object.ObjectKey {
+	return object.ObjectKey(ptr.Deref(o.Name, ""))
+}
+
+// GetType implements Object.
+func (o *V0045Qos) GetType() object.ObjectType {
+	return ObjectTypeV0045Qos
+}
+
+// DeepCopyObject implements Object.
+func (o *V0045Qos) DeepCopyObject() object.Object {
+	return o.DeepCopy()
+}
+
+func (o *V0045Qos) DeepCopy() *V0045Qos {
+	out := new(V0045Qos)
+	utils.RemarshalOrDie(o, out)
+	return out
+}
+
+func (o *V0045Qos) GetFlagsAsSet() set.Set[api.V0045QosFlags] {
+	out := make(set.Set[api.V0045QosFlags])
+	flags := ptr.Deref(o.Flags, []api.V0045QosFlags{})
+	for _, f := range flags {
+		out.Insert(f)
+	}
+	return out
+}
+
+// GetPreemptList returns the names of the QOS this QOS can preempt.
+func (o *V0045Qos) GetPreemptList() []string {
+	if o.Preempt == nil {
+		return []string{}
+	}
+	return ptr.Deref(o.Preempt.List, api.V0045QosPreemptList{})
+}
+
+type V0045QosList struct {
+	Items []V0045Qos
+}
+
+// GetType implements ObjectList.
+func (o *V0045QosList) GetType() object.ObjectType {
+	return ObjectTypeV0045Qos
+}
+
+// GetItems implements ObjectList.
+func (o *V0045QosList) GetItems() []object.Object {
+	list := make([]object.Object, len(o.Items))
+	for i, item := range o.Items {
+		list[i] = item.DeepCopyObject()
+	}
+	return list
+}
+
+// AppendItem implements ObjectList.
+func (o *V0045QosList) AppendItem(object object.Object) {
+	out, ok := object.(*V0045Qos)
+	if ok {
+		utils.RemarshalOrDie(object, out)
+		o.Items = append(o.Items, *out)
+	}
+}
+
+// DeepCopyObjectList implements ObjectList.
+func (o *V0045QosList) DeepCopyObjectList() object.ObjectList {
+	out := new(V0045QosList)
+	out.Items = make([]V0045Qos, len(o.Items))
+	for i, item := range o.Items {
+		out.Items[i] = *item.DeepCopy()
+	}
+	return out
+}
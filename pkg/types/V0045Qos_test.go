@@ -0,0 +1,406 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+	"k8s.io/utils/set"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+)
+
+func TestV0045Qos_GetKey(t *testing.T) {
+	type fields struct {
+		V0045Qos api.V0045Qos
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectKey
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Qos: api.V0045Qos{},
+			},
+			want: "",
+		},
+		{
+			name: "key",
+			fields: fields{
+				V0045Qos: api.V0045Qos{Name: ptr.To("test_0")},
+			},
+			want: "test_0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Qos{
+				V0045Qos: tt.fields.V0045Qos,
+			}
+			got := o.GetKey()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Qos_GetType(t *testing.T) {
+	type fields struct {
+		V0045Qos api.V0045Qos
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				V0045Qos: api.V0045Qos{},
+			},
+			want: ObjectTypeV0045Qos,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Qos{
+				V0045Qos: tt.fields.V0045Qos,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Qos_DeepCopyObject(t *testing.T) {
+	type fields struct {
+		V0045Qos api.V0045Qos
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Qos: api.V0045Qos{},
+			},
+			want: &V0045Qos{},
+		},
+		{
+			name: "id",
+			fields: fields{
+				V0045Qos: api.V0045Qos{Name: ptr.To("test_0")},
+			},
+			want: &V0045Qos{api.V0045Qos{Name: ptr.To("test_0")}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Qos{
+				V0045Qos: tt.fields.V0045Qos,
+			}
+			got := o.DeepCopyObject()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Qos_DeepCopy(t *testing.T) {
+	type fields struct {
+		V0045Qos api.V0045Qos
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   *V0045Qos
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Qos: api.V0045Qos{},
+			},
+			want: &V0045Qos{},
+		},
+		{
+			name: "id",
+			fields: fields{
+				V0045Qos: api.V0045Qos{Name: ptr.To("test_0")},
+			},
+			want: &V0045Qos{api.V0045Qos{Name: ptr.To("test_0")}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Qos{
+				V0045Qos: tt.fields.V0045Qos,
+			}
+			got := o.DeepCopy()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045QosList_GetType(t *testing.T) {
+	type fields struct {
+		Items []V0045Qos
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				Items: []V0045Qos{},
+			},
+			want: ObjectTypeV0045Qos,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045QosList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045QosList_GetItems(t *testing.T) {
+	type fields struct {
+		Items []V0045Qos
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   []object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045Qos{},
+			},
+			want: []object.Object{},
+		},
+		{
+			name: "items",
+			fields: fields{
+				Items: []V0045Qos{
+					{V0045Qos: api.V0045Qos{Name: ptr.To("test_0")}},
+					{V0045Qos: api.V0045Qos{Name: ptr.To("test_1")}},
+				},
+			},
+			want: []object.Object{
+				&V0045Qos{api.V0045Qos{Name: ptr.To("test_0")}},
+				&V0045Qos{api.V0045Qos{Name: ptr.To("test_1")}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045QosList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetItems()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045QosList_AppendItem(t *testing.T) {
+	type fields struct {
+		Items []V0045Qos
+	}
+	type args struct {
+		object object.Object
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		args       args
+		wantAppend bool
+	}{
+		{
+			name: "nil",
+			fields: fields{
+				Items: []V0045Qos{},
+			},
+			args: args{
+				object: nil,
+			},
+			wantAppend: false,
+		},
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045Qos{},
+			},
+			args: args{
+				object: &V0045Qos{},
+			},
+			wantAppend: true,
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0045Qos{
+					{V0045Qos: api.V0045Qos{Name: ptr.To("test_0")}},
+					{V0045Qos: api.V0045Qos{Name: ptr.To("test_1")}},
+				},
+			},
+			args: args{
+				object: &V0045Qos{},
+			},
+			wantAppend: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045QosList{
+				Items: tt.fields.Items,
+			}
+			want := len(o.GetItems())
+			if tt.wantAppend {
+				want++
+			}
+			o.AppendItem(tt.args.object)
+			got := len(o.GetItems())
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func TestV0045QosList_DeepCopyObjectList(t *testing.T) {
+	type fields struct {
+		Items []V0045Qos
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectList
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045Qos{},
+			},
+			want: &V0045QosList{
+				Items: []V0045Qos{},
+			},
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0045Qos{
+					{V0045Qos: api.V0045Qos{Name: ptr.To("test_0")}},
+					{V0045Qos: api.V0045Qos{Name: ptr.To("test_1")}},
+				},
+			},
+			want: &V0045QosList{
+				Items: []V0045Qos{
+					{api.V0045Qos{Name: ptr.To("test_0")}},
+					{api.V0045Qos{Name: ptr.To("test_1")}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045QosList{
+				Items: tt.fields.Items,
+			}
+			got := o.DeepCopyObjectList()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Qos_GetFlagsAsSet(t *testing.T) {
+	type fields struct {
+		V0045Qos api.V0045Qos
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   set.Set[api.V0045QosFlags]
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Qos: api.V0045Qos{},
+			},
+			want: set.New[api.V0045QosFlags](),
+		},
+		{
+			name: "multiple",
+			fields: fields{
+				V0045Qos: api.V0045Qos{
+					Flags: &[]api.V0045QosFlags{api.V0045QosFlagsDELETED, api.V0045QosFlagsDENYLIMIT},
+				},
+			},
+			want: set.New(api.V0045QosFlagsDELETED, api.V0045QosFlagsDENYLIMIT),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Qos{
+				V0045Qos: tt.fields.V0045Qos,
+			}
+			if got := o.GetFlagsAsSet(); !tt.want.Equal(got) {
+				t.Errorf("V0045Qos.GetFlagsAsSet() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestV0045Qos_GetPreemptList(t *testing.T) {
+	type fields struct {
+		V0045Qos api.V0045Qos
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   []string
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Qos: api.V0045Qos{},
+			},
+			want: []string{},
+		},
+		{
+			name: "preempt list",
+			fields: fields{
+				V0045Qos: api.V0045Qos{
+					Preempt: &struct {
+						ExemptTime *api.V0045Uint32NoValStruct `json:"exempt_time,omitempty"`
+						List       *api.V0045QosPreemptList    `json:"list,omitempty"`
+						Mode       *[]api.V0045QosPreemptMode  `json:"mode,omitempty"`
+					}{
+						List: &api.V0045QosPreemptList{"foo", "bar"},
+					},
+				},
+			},
+			want: []string{"foo", "bar"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Qos{
+				V0045Qos: tt.fields.V0045Qos,
+			}
+			got := o.GetPreemptList()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
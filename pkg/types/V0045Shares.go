@@ -0,0 +1,206 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+const (
+	ObjectTypeV0045Shares = "V0045Shares"
+)
+
+type V0045Shares struct {
+	api.V0045AssocSharesObjWrap
+}
+
+// GetKey implements Object.
+func (o *V0045Shares) GetKey() object.ObjectKey {
+	assocId := ptr.Deref(o.Id, 0)
+	return object.ObjectKey(fmt.Sprintf("%d", assocId))
+}
+
+// GetType implements Object.
+func (o *V0045Shares) GetType() object.ObjectType {
+	return ObjectTypeV0045Shares
+}
+
+// DeepCopyObject implements Object.
+func (o *V0045Shares) DeepCopyObject() object.Object {
+	return o.DeepCopy()
+}
+
+func (o *V0045Shares) DeepCopy() *V0045Shares {
+	out := new(V0045Shares)
+	utils.RemarshalOrDie(o, out)
+	return out
+}
+
+// IsUser returns true if the share belongs to a user association, otherwise
+// it belongs to an account association.
+func (o *V0045Shares) IsUser() bool {
+	for _, t := range ptr.Deref(o.Type, []api.V0045AssocSharesObjWrapType{}) {
+		if t == api.USER {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSharesNormalized returns the normalized shares of the association.
+func (o *V0045Shares) GetSharesNormalized() float64 {
+	return v0045Float64NoVal(o.SharesNormalized)
+}
+
+// GetEffectiveUsage returns the effective usage of the association.
+func (o *V0045Shares) GetEffectiveUsage() float64 {
+	return v0045Float64NoVal(o.EffectiveUsage)
+}
+
+// GetFairshareFactor returns the fairshare factor of the association.
+func (o *V0045Shares) GetFairshareFactor() float64 {
+	if o.Fairshare == nil {
+		return 0
+	}
+	return v0045Float64NoVal(o.Fairshare.Factor)
+}
+
+func v0045Float64NoVal(v *api.V0045Float64NoValStruct) float64 {
+	if v == nil || !ptr.Deref(v.Set, false) {
+		return 0
+	}
+	return ptr.Deref(v.Number, 0)
+}
+
+// V0045SharesTree is a node in the association tree built from a flat list
+// of shares. Account nodes may have children, user nodes never do.
+type V0045SharesTree struct {
+	V0045Shares
+
+	ParentNode *V0045SharesTree
+	Children   []*V0045SharesTree
+}
+
+// Walk calls fn for the node and all its descendants, depth-first. If fn
+// returns false, the children of that node are skipped.
+func (o *V0045SharesTree) Walk(fn func(node *V0045SharesTree, depth int) bool) {
+	o.walk(fn, 0)
+}
+
+func (o *V0045SharesTree) walk(fn func(node *V0045SharesTree, depth int) bool, depth int) {
+	if !fn(o, depth) {
+		return
+	}
+	for _, child := range o.Children {
+		child.walk(fn, depth+1)
+	}
+}
+
+// Find returns the first node, depth-first, matching the given name and
+// association kind, or nil if not found.
+func (o *V0045SharesTree) Find(name string, isUser bool) *V0045SharesTree {
+	var found *V0045SharesTree
+	o.Walk(func(node *V0045SharesTree, _ int) bool {
+		if found != nil {
+			return false
+		}
+		if ptr.Deref(node.Name, "") == name && node.IsUser() == isUser {
+			found = node
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// Path returns the nodes from the root of the tree down to this node.
+func (o *V0045SharesTree) Path() []*V0045SharesTree {
+	path := []*V0045SharesTree{}
+	for node := o; node != nil; node = node.ParentNode {
+		path = append([]*V0045SharesTree{node}, path...)
+	}
+	return path
+}
+
+type V0045SharesList struct {
+	Items []V0045Shares
+}
+
+// GetType implements ObjectList.
+func (o *V0045SharesList) GetType() object.ObjectType {
+	return ObjectTypeV0045Shares
+}
+
+// GetItems implements ObjectList.
+func (o *V0045SharesList) GetItems() []object.Object {
+	list := make([]object.Object, len(o.Items))
+	for i, item := range o.Items {
+		list[i] = item.DeepCopyObject()
+	}
+	return list
+}
+
+// AppendItem implements ObjectList.
+func (o *V0045SharesList) AppendItem(object object.Object) {
+	out, ok := object.(*V0045Shares)
+	if ok {
+		utils.RemarshalOrDie(object, out)
+		o.Items = append(o.Items, *out)
+	}
+}
+
+// DeepCopyObjectList implements ObjectList.
+func (o *V0045SharesList) DeepCopyObjectList() object.ObjectList {
+	out := new(V0045SharesList)
+	out.Items = make([]V0045Shares, len(o.Items))
+	for i, item := range o.Items {
+		out.Items[i] = *item.DeepCopy()
+	}
+	return out
+}
+
+// Tree builds the association tree from the list, returning the root nodes
+// in list order. Shares whose parent account is not in the list become roots.
+func (o *V0045SharesList) Tree() []*V0045SharesTree {
+	type accountKey struct {
+		cluster string
+		name    string
+	}
+
+	nodes := make([]*V0045SharesTree, len(o.Items))
+	accounts := make(map[accountKey]*V0045SharesTree)
+	for i := range o.Items {
+		node := &V0045SharesTree{V0045Shares: *o.Items[i].DeepCopy()}
+		nodes[i] = node
+		if !node.IsUser() {
+			key := accountKey{
+				cluster: ptr.Deref(node.Cluster, ""),
+				name:    ptr.Deref(node.Name, ""),
+			}
+			accounts[key] = node
+		}
+	}
+
+	roots := []*V0045SharesTree{}
+	for _, node := range nodes {
+		key := accountKey{
+			cluster: ptr.Deref(node.Cluster, ""),
+			name:    ptr.Deref(node.Parent, ""),
+		}
+		parent, ok := accounts[key]
+		if !ok || key.name == "" || parent == node {
+			roots = append(roots, node)
+			continue
+		}
+		node.ParentNode = parent
+		parent.Children = append(parent.Children, node)
+	}
+	return roots
+}
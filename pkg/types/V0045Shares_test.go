@@ -0,0 +1,526 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+)
+
+func TestV0045Shares_GetKey(t *testing.T) {
+	type fields struct {
+		V0045AssocSharesObjWrap api.V0045AssocSharesObjWrap
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectKey
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045AssocSharesObjWrap: api.V0045AssocSharesObjWrap{},
+			},
+			want: "0",
+		},
+		{
+			name: "key",
+			fields: fields{
+				V0045AssocSharesObjWrap: api.V0045AssocSharesObjWrap{Id: ptr.To[int32](1)},
+			},
+			want: "1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Shares{
+				V0045AssocSharesObjWrap: tt.fields.V0045AssocSharesObjWrap,
+			}
+			got := o.GetKey()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Shares_GetType(t *testing.T) {
+	type fields struct {
+		V0045AssocSharesObjWrap api.V0045AssocSharesObjWrap
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				V0045AssocSharesObjWrap: api.V0045AssocSharesObjWrap{},
+			},
+			want: ObjectTypeV0045Shares,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Shares{
+				V0045AssocSharesObjWrap: tt.fields.V0045AssocSharesObjWrap,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Shares_DeepCopyObject(t *testing.T) {
+	type fields struct {
+		V0045AssocSharesObjWrap api.V0045AssocSharesObjWrap
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045AssocSharesObjWrap: api.V0045AssocSharesObjWrap{},
+			},
+			want: &V0045Shares{},
+		},
+		{
+			name: "id",
+			fields: fields{
+				V0045AssocSharesObjWrap: api.V0045AssocSharesObjWrap{Id: ptr.To[int32](1)},
+			},
+			want: &V0045Shares{api.V0045AssocSharesObjWrap{Id: ptr.To[int32](1)}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Shares{
+				V0045AssocSharesObjWrap: tt.fields.V0045AssocSharesObjWrap,
+			}
+			got := o.DeepCopyObject()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Shares_DeepCopy(t *testing.T) {
+	type fields struct {
+		V0045AssocSharesObjWrap api.V0045AssocSharesObjWrap
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   *V0045Shares
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045AssocSharesObjWrap: api.V0045AssocSharesObjWrap{},
+			},
+			want: &V0045Shares{},
+		},
+		{
+			name: "id",
+			fields: fields{
+				V0045AssocSharesObjWrap: api.V0045AssocSharesObjWrap{Id: ptr.To[int32](1)},
+			},
+			want: &V0045Shares{api.V0045AssocSharesObjWrap{Id: ptr.To[int32](1)}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Shares{
+				V0045AssocSharesObjWrap: tt.fields.V0045AssocSharesObjWrap,
+			}
+			got := o.DeepCopy()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Shares_IsUser(t *testing.T) {
+	type fields struct {
+		V0045AssocSharesObjWrap api.V0045AssocSharesObjWrap
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   bool
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045AssocSharesObjWrap: api.V0045AssocSharesObjWrap{},
+			},
+			want: false,
+		},
+		{
+			name: "account",
+			fields: fields{
+				V0045AssocSharesObjWrap: api.V0045AssocSharesObjWrap{
+					Type: &[]api.V0045AssocSharesObjWrapType{api.ASSOCIATION},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "user",
+			fields: fields{
+				V0045AssocSharesObjWrap: api.V0045AssocSharesObjWrap{
+					Type: &[]api.V0045AssocSharesObjWrapType{api.USER},
+				},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Shares{
+				V0045AssocSharesObjWrap: tt.fields.V0045AssocSharesObjWrap,
+			}
+			got := o.IsUser()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Shares_Factors(t *testing.T) {
+	type fields struct {
+		V0045AssocSharesObjWrap api.V0045AssocSharesObjWrap
+	}
+	tests := []struct {
+		name                 string
+		fields               fields
+		wantSharesNormalized float64
+		wantEffectiveUsage   float64
+		wantFairshareFactor  float64
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045AssocSharesObjWrap: api.V0045AssocSharesObjWrap{},
+			},
+		},
+		{
+			name: "unset",
+			fields: fields{
+				V0045AssocSharesObjWrap: api.V0045AssocSharesObjWrap{
+					SharesNormalized: &api.V0045Float64NoValStruct{Set: ptr.To(false), Number: ptr.To(0.5)},
+				},
+			},
+		},
+		{
+			name: "set",
+			fields: fields{
+				V0045AssocSharesObjWrap: api.V0045AssocSharesObjWrap{
+					SharesNormalized: &api.V0045Float64NoValStruct{Set: ptr.To(true), Number: ptr.To(0.5)},
+					EffectiveUsage:   &api.V0045Float64NoValStruct{Set: ptr.To(true), Number: ptr.To(0.25)},
+					Fairshare: &struct {
+						Factor *api.V0045Float64NoValStruct `json:"factor,omitempty"`
+						Level  *api.V0045Float64NoValStruct `json:"level,omitempty"`
+					}{
+						Factor: &api.V0045Float64NoValStruct{Set: ptr.To(true), Number: ptr.To(0.75)},
+					},
+				},
+			},
+			wantSharesNormalized: 0.5,
+			wantEffectiveUsage:   0.25,
+			wantFairshareFactor:  0.75,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Shares{
+				V0045AssocSharesObjWrap: tt.fields.V0045AssocSharesObjWrap,
+			}
+			require.Equal(t, tt.wantSharesNormalized, o.GetSharesNormalized())
+			require.Equal(t, tt.wantEffectiveUsage, o.GetEffectiveUsage())
+			require.Equal(t, tt.wantFairshareFactor, o.GetFairshareFactor())
+		})
+	}
+}
+
+func TestV0045SharesList_GetType(t *testing.T) {
+	type fields struct {
+		Items []V0045Shares
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				Items: []V0045Shares{},
+			},
+			want: ObjectTypeV0045Shares,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045SharesList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045SharesList_GetItems(t *testing.T) {
+	type fields struct {
+		Items []V0045Shares
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   []object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045Shares{},
+			},
+			want: []object.Object{},
+		},
+		{
+			name: "items",
+			fields: fields{
+				Items: []V0045Shares{
+					{V0045AssocSharesObjWrap: api.V0045AssocSharesObjWrap{Id: ptr.To[int32](1)}},
+					{V0045AssocSharesObjWrap: api.V0045AssocSharesObjWrap{Id: ptr.To[int32](2)}},
+				},
+			},
+			want: []object.Object{
+				&V0045Shares{api.V0045AssocSharesObjWrap{Id: ptr.To[int32](1)}},
+				&V0045Shares{api.V0045AssocSharesObjWrap{Id: ptr.To[int32](2)}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045SharesList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetItems()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045SharesList_AppendItem(t *testing.T) {
+	type fields struct {
+		Items []V0045Shares
+	}
+	type args struct {
+		object object.Object
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		args       args
+		wantAppend bool
+	}{
+		{
+			name: "nil",
+			fields: fields{
+				Items: []V0045Shares{},
+			},
+			args: args{
+				object: nil,
+			},
+			wantAppend: false,
+		},
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045Shares{},
+			},
+			args: args{
+				object: &V0045Shares{},
+			},
+			wantAppend: true,
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0045Shares{
+					{V0045AssocSharesObjWrap: api.V0045AssocSharesObjWrap{Id: ptr.To[int32](1)}},
+					{V0045AssocSharesObjWrap: api.V0045AssocSharesObjWrap{Id: ptr.To[int32](2)}},
+				},
+			},
+			args: args{
+				object: &V0045Shares{},
+			},
+			wantAppend: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045SharesList{
+				Items: tt.fields.Items,
+			}
+			want := len(o.GetItems())
+			if tt.wantAppend {
+				want++
+			}
+			o.AppendItem(tt.args.object)
+			got := len(o.GetItems())
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func TestV0045SharesList_DeepCopyObjectList(t *testing.T) {
+	type fields struct {
+		Items []V0045Shares
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectList
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045Shares{},
+			},
+			want: &V0045SharesList{
+				Items: []V0045Shares{},
+			},
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0045Shares{
+					{V0045AssocSharesObjWrap: api.V0045AssocSharesObjWrap{Id: ptr.To[int32](1)}},
+					{V0045AssocSharesObjWrap: api.V0045AssocSharesObjWrap{Id: ptr.To[int32](2)}},
+				},
+			},
+			want: &V0045SharesList{
+				Items: []V0045Shares{
+					{api.V0045AssocSharesObjWrap{Id: ptr.To[int32](1)}},
+					{api.V0045AssocSharesObjWrap{Id: ptr.To[int32](2)}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045SharesList{
+				Items: tt.fields.Items,
+			}
+			got := o.DeepCopyObjectList()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045SharesList_Tree(t *testing.T) {
+	account := func(id int32, name, parent string) V0045Shares {
+		return V0045Shares{api.V0045AssocSharesObjWrap{
+			Id:     ptr.To(id),
+			Name:   ptr.To(name),
+			Parent: ptr.To(parent),
+			Type:   &[]api.V0045AssocSharesObjWrapType{api.ASSOCIATION},
+		}}
+	}
+	user := func(id int32, name, parent string) V0045Shares {
+		return V0045Shares{api.V0045AssocSharesObjWrap{
+			Id:     ptr.To(id),
+			Name:   ptr.To(name),
+			Parent: ptr.To(parent),
+			Type:   &[]api.V0045AssocSharesObjWrapType{api.USER},
+		}}
+	}
+	// flatten renders the tree as "depth:key" for comparison.
+	flatten := func(roots []*V0045SharesTree) []string {
+		out := []string{}
+		for _, root := range roots {
+			root.Walk(func(node *V0045SharesTree, depth int) bool {
+				out = append(out, fmt.Sprintf("%d:%s", depth, node.GetKey()))
+				return true
+			})
+		}
+		return out
+	}
+	tests := []struct {
+		name  string
+		items []V0045Shares
+		want  []string
+	}{
+		{
+			name:  "empty",
+			items: []V0045Shares{},
+			want:  []string{},
+		},
+		{
+			name: "tree",
+			items: []V0045Shares{
+				account(1, "root", ""),
+				user(2, "root", "root"),
+				account(3, "physics", "root"),
+				user(4, "alice", "physics"),
+				account(5, "chemistry", "root"),
+				user(6, "bob", "chemistry"),
+				user(7, "carol", "physics"),
+			},
+			want: []string{"0:1", "1:2", "1:3", "2:4", "2:7", "1:5", "2:6"},
+		},
+		{
+			name: "orphan",
+			items: []V0045Shares{
+				account(1, "root", ""),
+				user(2, "alice", "missing"),
+			},
+			want: []string{"0:1", "0:2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045SharesList{
+				Items: tt.items,
+			}
+			got := flatten(o.Tree())
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045SharesTree_FindPath(t *testing.T) {
+	list := &V0045SharesList{
+		Items: []V0045Shares{
+			{api.V0045AssocSharesObjWrap{Id: ptr.To[int32](1), Name: ptr.To("root")}},
+			{api.V0045AssocSharesObjWrap{Id: ptr.To[int32](2), Name: ptr.To("physics"), Parent: ptr.To("root")}},
+			{api.V0045AssocSharesObjWrap{
+				Id:     ptr.To[int32](3),
+				Name:   ptr.To("alice"),
+				Parent: ptr.To("physics"),
+				Type:   &[]api.V0045AssocSharesObjWrapType{api.USER},
+			}},
+		},
+	}
+	roots := list.Tree()
+	require.Len(t, roots, 1)
+
+	require.Nil(t, roots[0].Find("alice", false))
+	require.Nil(t, roots[0].Find("bob", true))
+
+	node := roots[0].Find("alice", true)
+	require.NotNil(t, node)
+	path := []object.ObjectKey{}
+	for _, n := range node.Path() {
+		path = append(path, n.GetKey())
+	}
+	require.Equal(t, []object.ObjectKey{"1", "2", "3"}, path)
+}
@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+const (
+	ObjectTypeV0045Tres = "V0045Tres"
+)
+
+const tresSeparator = "/"
+
+type V0045Tres struct {
+	api.V0045Tres
+}
+
+// GetKey implements Object.
+func (o *V0045Tres) GetKey() object.ObjectKey {
+	return object.ObjectKey(o.GetTresString())
+}
+
+// GetType implements Object.
+func (o *V0045Tres) GetType() object.ObjectType {
+	return ObjectTypeV0045Tres
+}
+
+// DeepCopyObject implements Object.
+func (o *V0045Tres) DeepCopyObject() object.Object {
+	return o.DeepCopy()
+}
+
+func (o *V0045Tres) DeepCopy() *V0045Tres {
+	out := new(V0045Tres)
+	utils.RemarshalOrDie(o, out)
+	return out
+}
+
+// GetTresString returns the TRES in Slurm "type[/name]" notation
+// (e.g. "cpu", "gres/gpu").
+func (o *V0045Tres) GetTresString() string {
+	return formatTresString(o.Type, ptr.Deref(o.Name, ""))
+}
+
+func formatTresString(tresType, tresName string) string {
+	if tresName == "" {
+		return tresType
+	}
+	return tresType + tresSeparator + tresName
+}
+
+type V0045TresList struct {
+	Items []V0045Tres
+}
+
+// GetType implements ObjectList.
+func (o *V0045TresList) GetType() object.ObjectType {
+	return ObjectTypeV0045Tres
+}
+
+// GetItems implements ObjectList.
+func (o *V0045TresList) GetItems() []object.Object {
+	list := make([]object.Object, len(o.Items))
+	for i, item := range o.Items {
+		list[i] = item.DeepCopyObject()
+	}
+	return list
+}
+
+// AppendItem implements ObjectList.
+func (o *V0045TresList) AppendItem(object object.Object) {
+	out, ok := object.(*V0045Tres)
+	if ok {
+		utils.RemarshalOrDie(object, out)
+		o.Items = append(o.Items, *out)
+	}
+}
+
+// DeepCopyObjectList implements ObjectList.
+func (o *V0045TresList) DeepCopyObjectList() object.ObjectList {
+	out := new(V0045TresList)
+	out.Items = make([]V0045Tres, len(o.Items))
+	for i, item := range o.Items {
+		out.Items[i] = *item.DeepCopy()
+	}
+	return out
+}
+
+// GetById returns the TRES with the given database id.
+func (o *V0045TresList) GetById(id int32) (*V0045Tres, bool) {
+	for i := range o.Items {
+		if ptr.Deref(o.Items[i].Id, 0) == id {
+			return &o.Items[i], true
+		}
+	}
+	return nil, false
+}
+
+// GetByTresString returns the TRES with the given "type[/name]".
+func (o *V0045TresList) GetByTresString(tres string) (*V0045Tres, bool) {
+	for i := range o.Items {
+		if o.Items[i].GetTresString() == tres {
+			return &o.Items[i], true
+		}
+	}
+	return nil, false
+}
+
+// Resolve returns a copy of tres where each entry has its id, type and name
+// filled in from the catalog. Entries unknown to the catalog are kept as-is.
+func (o *V0045TresList) Resolve(tres api.V0045TresList) api.V0045TresList {
+	out := make(api.V0045TresList, len(tres))
+	for i, item := range tres {
+		out[i] = item
+		var known *V0045Tres
+		var ok bool
+		if item.Id != nil {
+			known, ok = o.GetById(*item.Id)
+		} else if item.Type != "" {
+			known, ok = o.GetByTresString(formatTresString(item.Type, ptr.Deref(item.Name, "")))
+		}
+		if !ok {
+			continue
+		}
+		resolved := known.DeepCopy()
+		out[i].Id = resolved.Id
+		out[i].Type = resolved.Type
+		out[i].Name = resolved.Name
+	}
+	return out
+}
+
+// Format renders tres as a human-readable Slurm TRES string
+// (e.g. "cpu=4,mem=1024,gres/gpu=2"), resolving ids through the catalog.
+// Entries unknown to the catalog are rendered by their id.
+func (o *V0045TresList) Format(tres api.V0045TresList) string {
+	resolved := o.Resolve(tres)
+	parts := make([]string, 0, len(resolved))
+	for _, item := range resolved {
+		key := formatTresString(item.Type, ptr.Deref(item.Name, ""))
+		if key == "" {
+			key = strconv.Itoa(int(ptr.Deref(item.Id, 0)))
+		}
+		parts = append(parts, fmt.Sprintf("%s=%d", key, ptr.Deref(item.Count, 0)))
+	}
+	return strings.Join(parts, ",")
+}
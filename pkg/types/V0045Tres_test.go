@@ -0,0 +1,515 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+)
+
+func TestV0045Tres_GetKey(t *testing.T) {
+	type fields struct {
+		V0045Tres api.V0045Tres
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectKey
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Tres: api.V0045Tres{},
+			},
+			want: "",
+		},
+		{
+			name: "key",
+			fields: fields{
+				V0045Tres: api.V0045Tres{Type: "test_0"},
+			},
+			want: "test_0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Tres{
+				V0045Tres: tt.fields.V0045Tres,
+			}
+			got := o.GetKey()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Tres_GetType(t *testing.T) {
+	type fields struct {
+		V0045Tres api.V0045Tres
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				V0045Tres: api.V0045Tres{},
+			},
+			want: ObjectTypeV0045Tres,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Tres{
+				V0045Tres: tt.fields.V0045Tres,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Tres_DeepCopyObject(t *testing.T) {
+	type fields struct {
+		V0045Tres api.V0045Tres
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Tres: api.V0045Tres{},
+			},
+			want: &V0045Tres{},
+		},
+		{
+			name: "id",
+			fields: fields{
+				V0045Tres: api.V0045Tres{Type: "test_0"},
+			},
+			want: &V0045Tres{api.V0045Tres{Type: "test_0"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Tres{
+				V0045Tres: tt.fields.V0045Tres,
+			}
+			got := o.DeepCopyObject()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Tres_DeepCopy(t *testing.T) {
+	type fields struct {
+		V0045Tres api.V0045Tres
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   *V0045Tres
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Tres: api.V0045Tres{},
+			},
+			want: &V0045Tres{},
+		},
+		{
+			name: "id",
+			fields: fields{
+				V0045Tres: api.V0045Tres{Type: "test_0"},
+			},
+			want: &V0045Tres{api.V0045Tres{Type: "test_0"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Tres{
+				V0045Tres: tt.fields.V0045Tres,
+			}
+			got := o.DeepCopy()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045TresList_GetType(t *testing.T) {
+	type fields struct {
+		Items []V0045Tres
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				Items: []V0045Tres{},
+			},
+			want: ObjectTypeV0045Tres,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045TresList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045TresList_GetItems(t *testing.T) {
+	type fields struct {
+		Items []V0045Tres
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   []object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045Tres{},
+			},
+			want: []object.Object{},
+		},
+		{
+			name: "items",
+			fields: fields{
+				Items: []V0045Tres{
+					{V0045Tres: api.V0045Tres{Type: "test_0"}},
+					{V0045Tres: api.V0045Tres{Type: "test_1"}},
+				},
+			},
+			want: []object.Object{
+				&V0045Tres{api.V0045Tres{Type: "test_0"}},
+				&V0045Tres{api.V0045Tres{Type: "test_1"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045TresList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetItems()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045TresList_AppendItem(t *testing.T) {
+	type fields struct {
+		Items []V0045Tres
+	}
+	type args struct {
+		object object.Object
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		args       args
+		wantAppend bool
+	}{
+		{
+			name: "nil",
+			fields: fields{
+				Items: []V0045Tres{},
+			},
+			args: args{
+				object: nil,
+			},
+			wantAppend: false,
+		},
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045Tres{},
+			},
+			args: args{
+				object: &V0045Tres{},
+			},
+			wantAppend: true,
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0045Tres{
+					{V0045Tres: api.V0045Tres{Type: "test_0"}},
+					{V0045Tres: api.V0045Tres{Type: "test_1"}},
+				},
+			},
+			args: args{
+				object: &V0045Tres{},
+			},
+			wantAppend: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045TresList{
+				Items: tt.fields.Items,
+			}
+			want := len(o.GetItems())
+			if tt.wantAppend {
+				want++
+			}
+			o.AppendItem(tt.args.object)
+			got := len(o.GetItems())
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func TestV0045TresList_DeepCopyObjectList(t *testing.T) {
+	type fields struct {
+		Items []V0045Tres
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectList
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045Tres{},
+			},
+			want: &V0045TresList{
+				Items: []V0045Tres{},
+			},
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0045Tres{
+					{V0045Tres: api.V0045Tres{Type: "test_0"}},
+					{V0045Tres: api.V0045Tres{Type: "test_1"}},
+				},
+			},
+			want: &V0045TresList{
+				Items: []V0045Tres{
+					{api.V0045Tres{Type: "test_0"}},
+					{api.V0045Tres{Type: "test_1"}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045TresList{
+				Items: tt.fields.Items,
+			}
+			got := o.DeepCopyObjectList()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045Tres_GetTresString(t *testing.T) {
+	type fields struct {
+		V0045Tres api.V0045Tres
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   string
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Tres: api.V0045Tres{},
+			},
+			want: "",
+		},
+		{
+			name: "type only",
+			fields: fields{
+				V0045Tres: api.V0045Tres{Type: "cpu"},
+			},
+			want: "cpu",
+		},
+		{
+			name: "type and name",
+			fields: fields{
+				V0045Tres: api.V0045Tres{Type: "gres", Name: ptr.To("gpu")},
+			},
+			want: "gres/gpu",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045Tres{
+				V0045Tres: tt.fields.V0045Tres,
+			}
+			require.Equal(t, tt.want, o.GetTresString())
+		})
+	}
+}
+
+var testV0045TresCatalog = &V0045TresList{
+	Items: []V0045Tres{
+		{V0045Tres: api.V0045Tres{Id: ptr.To[int32](1), Type: "cpu"}},
+		{V0045Tres: api.V0045Tres{Id: ptr.To[int32](2), Type: "mem"}},
+		{V0045Tres: api.V0045Tres{Id: ptr.To[int32](1001), Type: "gres", Name: ptr.To("gpu")}},
+	},
+}
+
+func TestV0045TresList_GetById(t *testing.T) {
+	tests := []struct {
+		name   string
+		id     int32
+		want   string
+		wantOk bool
+	}{
+		{
+			name:   "found",
+			id:     1001,
+			want:   "gres/gpu",
+			wantOk: true,
+		},
+		{
+			name:   "not found",
+			id:     3,
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := testV0045TresCatalog.GetById(tt.id)
+			require.Equal(t, tt.wantOk, ok)
+			if ok {
+				require.Equal(t, tt.want, got.GetTresString())
+			}
+		})
+	}
+}
+
+func TestV0045TresList_GetByTresString(t *testing.T) {
+	tests := []struct {
+		name   string
+		tres   string
+		want   int32
+		wantOk bool
+	}{
+		{
+			name:   "found",
+			tres:   "gres/gpu",
+			want:   1001,
+			wantOk: true,
+		},
+		{
+			name:   "case sensitive",
+			tres:   "CPU",
+			wantOk: false,
+		},
+		{
+			name:   "not found",
+			tres:   "gres/foo",
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := testV0045TresCatalog.GetByTresString(tt.tres)
+			require.Equal(t, tt.wantOk, ok)
+			if ok {
+				require.Equal(t, tt.want, ptr.Deref(got.Id, 0))
+			}
+		})
+	}
+}
+
+func TestV0045TresList_Resolve(t *testing.T) {
+	tests := []struct {
+		name string
+		tres api.V0045TresList
+		want api.V0045TresList
+	}{
+		{
+			name: "empty",
+			tres: api.V0045TresList{},
+			want: api.V0045TresList{},
+		},
+		{
+			name: "by id",
+			tres: api.V0045TresList{
+				{Id: ptr.To[int32](1001), Count: ptr.To[int64](2)},
+			},
+			want: api.V0045TresList{
+				{Id: ptr.To[int32](1001), Type: "gres", Name: ptr.To("gpu"), Count: ptr.To[int64](2)},
+			},
+		},
+		{
+			name: "by type and name",
+			tres: api.V0045TresList{
+				{Type: "cpu", Count: ptr.To[int64](4)},
+			},
+			want: api.V0045TresList{
+				{Id: ptr.To[int32](1), Type: "cpu", Count: ptr.To[int64](4)},
+			},
+		},
+		{
+			name: "unknown",
+			tres: api.V0045TresList{
+				{Id: ptr.To[int32](42), Count: ptr.To[int64](1)},
+			},
+			want: api.V0045TresList{
+				{Id: ptr.To[int32](42), Count: ptr.To[int64](1)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := testV0045TresCatalog.Resolve(tt.tres)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045TresList_Format(t *testing.T) {
+	tests := []struct {
+		name string
+		tres api.V0045TresList
+		want string
+	}{
+		{
+			name: "empty",
+			tres: api.V0045TresList{},
+			want: "",
+		},
+		{
+			name: "mixed",
+			tres: api.V0045TresList{
+				{Id: ptr.To[int32](1), Count: ptr.To[int64](4)},
+				{Type: "mem", Count: ptr.To[int64](1024)},
+				{Id: ptr.To[int32](1001), Count: ptr.To[int64](2)},
+				{Id: ptr.To[int32](42), Count: ptr.To[int64](1)},
+			},
+			want: "cpu=4,mem=1024,gres/gpu=2,42=1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := testV0045TresCatalog.Format(tt.tres)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"slices"
+
+	"k8s.io/utils/ptr"
+	"k8s.io/utils/set"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+const (
+	ObjectTypeV0045User = "V0045User"
+)
+
+type V0045User struct {
+	api.V0045User
+}
+
+// GetKey implements Object.
+func (o *V0045User) GetKey() object.ObjectKey {
+	return object.ObjectKey(o.Name)
+}
+
+// GetType implements Object.
+func (o *V0045User) GetType() object.ObjectType {
+	return ObjectTypeV0045User
+}
+
+// DeepCopyObject implements Object.
+func (o *V0045User) DeepCopyObject() object.Object {
+	return o.DeepCopy()
+}
+
+func (o *V0045User) DeepCopy() *V0045User {
+	out := new(V0045User)
+	utils.RemarshalOrDie(o, out)
+	return out
+}
+
+func (o *V0045User) GetFlagsAsSet() set.Set[api.V0045UserFlags] {
+	out := make(set.Set[api.V0045UserFlags])
+	flags := ptr.Deref(o.Flags, []api.V0045UserFlags{})
+	for _, f := range flags {
+		out.Insert(f)
+	}
+	return out
+}
+
+// GetDefaultAccount returns the default account of the user, if any.
+func (o *V0045User) GetDefaultAccount() string {
+	if o.Default == nil {
+		return ""
+	}
+	return ptr.Deref(o.Default.Account, "")
+}
+
+// GetCoordinatorAccounts returns the names of the accounts the user coordinates.
+// Requires the user to be fetched with coordinators.
+func (o *V0045User) GetCoordinatorAccounts() []string {
+	coords := ptr.Deref(o.Coordinators, api.V0045CoordList{})
+	out := make([]string, len(coords))
+	for i, coord := range coords {
+		out[i] = coord.Name
+	}
+	return out
+}
+
+// IsCoordinatorOf returns true if the user coordinates the account.
+// Requires the user to be fetched with coordinators.
+func (o *V0045User) IsCoordinatorOf(account string) bool {
+	return slices.Contains(o.GetCoordinatorAccounts(), account)
+}
+
+// GetAccountNames returns the unique account names the user is associated with.
+// Requires the user to be fetched with associations.
+func (o *V0045User) GetAccountNames() []string {
+	assocs := ptr.Deref(o.Associations, api.V0045AssocShortList{})
+	out := make([]string, 0, len(assocs))
+	for _, assoc := range assocs {
+		account := ptr.Deref(assoc.Account, "")
+		if account == "" || slices.Contains(out, account) {
+			continue
+		}
+		out = append(out, account)
+	}
+	return out
+}
+
+// HasAssociation returns true if the user has an association with the account.
+// An empty cluster matches any. The partition must match exactly, where an empty
+// partition is the account-wide association.
+// Requires the user to be fetched with associations.
+func (o *V0045User) HasAssociation(account, cluster, partition string) bool {
+	assocs := ptr.Deref(o.Associations, api.V0045AssocShortList{})
+	for _, assoc := range assocs {
+		if ptr.Deref(assoc.Account, "") != account {
+			continue
+		}
+		if cluster != "" && ptr.Deref(assoc.Cluster, "") != cluster {
+			continue
+		}
+		if ptr.Deref(assoc.Partition, "") != partition {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+type V0045UserList struct {
+	Items []V0045User
+}
+
+// GetType implements ObjectList.
+func (o *V0045UserList) GetType() object.ObjectType {
+	return ObjectTypeV0045User
+}
+
+// GetItems implements ObjectList.
+func (o *V0045UserList) GetItems() []object.Object {
+	list := make([]object.Object, len(o.Items))
+	for i, item := range o.Items {
+		list[i] = item.DeepCopyObject()
+	}
+	return list
+}
+
+// AppendItem implements ObjectList.
+func (o *V0045UserList) AppendItem(object object.Object) {
+	out, ok := object.(*V0045User)
+	if ok {
+		utils.RemarshalOrDie(object, out)
+		o.Items = append(o.Items, *out)
+	}
+}
+
+// DeepCopyObjectList implements ObjectList.
+func (o *V0045UserList) DeepCopyObjectList() object.ObjectList {
+	out := new(V0045UserList)
+	out.Items = make([]V0045User, len(o.Items))
+	for i, item := range o.Items {
+		out.Items[i] = *item.DeepCopy()
+	}
+	return out
+}
@@ -0,0 +1,638 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+	"k8s.io/utils/set"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+)
+
+func TestV0045User_GetKey(t *testing.T) {
+	type fields struct {
+		V0045User api.V0045User
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectKey
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045User: api.V0045User{},
+			},
+			want: "",
+		},
+		{
+			name: "key",
+			fields: fields{
+				V0045User: api.V0045User{Name: "test_0"},
+			},
+			want: "test_0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045User{
+				V0045User: tt.fields.V0045User,
+			}
+			got := o.GetKey()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045User_GetType(t *testing.T) {
+	type fields struct {
+		V0045User api.V0045User
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				V0045User: api.V0045User{},
+			},
+			want: ObjectTypeV0045User,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045User{
+				V0045User: tt.fields.V0045User,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045User_DeepCopyObject(t *testing.T) {
+	type fields struct {
+		V0045User api.V0045User
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045User: api.V0045User{},
+			},
+			want: &V0045User{},
+		},
+		{
+			name: "id",
+			fields: fields{
+				V0045User: api.V0045User{Name: "test_0"},
+			},
+			want: &V0045User{api.V0045User{Name: "test_0"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045User{
+				V0045User: tt.fields.V0045User,
+			}
+			got := o.DeepCopyObject()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045User_DeepCopy(t *testing.T) {
+	type fields struct {
+		V0045User api.V0045User
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   *V0045User
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045User: api.V0045User{},
+			},
+			want: &V0045User{},
+		},
+		{
+			name: "id",
+			fields: fields{
+				V0045User: api.V0045User{Name: "test_0"},
+			},
+			want: &V0045User{api.V0045User{Name: "test_0"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045User{
+				V0045User: tt.fields.V0045User,
+			}
+			got := o.DeepCopy()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045UserList_GetType(t *testing.T) {
+	type fields struct {
+		Items []V0045User
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				Items: []V0045User{},
+			},
+			want: ObjectTypeV0045User,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045UserList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045UserList_GetItems(t *testing.T) {
+	type fields struct {
+		Items []V0045User
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   []object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045User{},
+			},
+			want: []object.Object{},
+		},
+		{
+			name: "items",
+			fields: fields{
+				Items: []V0045User{
+					{V0045User: api.V0045User{Name: "test_0"}},
+					{V0045User: api.V0045User{Name: "test_1"}},
+				},
+			},
+			want: []object.Object{
+				&V0045User{api.V0045User{Name: "test_0"}},
+				&V0045User{api.V0045User{Name: "test_1"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045UserList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetItems()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045UserList_AppendItem(t *testing.T) {
+	type fields struct {
+		Items []V0045User
+	}
+	type args struct {
+		object object.Object
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		args       args
+		wantAppend bool
+	}{
+		{
+			name: "nil",
+			fields: fields{
+				Items: []V0045User{},
+			},
+			args: args{
+				object: nil,
+			},
+			wantAppend: false,
+		},
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045User{},
+			},
+			args: args{
+				object: &V0045User{},
+			},
+			wantAppend: true,
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0045User{
+					{V0045User: api.V0045User{Name: "test_0"}},
+					{V0045User: api.V0045User{Name: "test_1"}},
+				},
+			},
+			args: args{
+				object: &V0045User{},
+			},
+			wantAppend: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045UserList{
+				Items: tt.fields.Items,
+			}
+			want := len(o.GetItems())
+			if tt.wantAppend {
+				want++
+			}
+			o.AppendItem(tt.args.object)
+			got := len(o.GetItems())
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func TestV0045UserList_DeepCopyObjectList(t *testing.T) {
+	type fields struct {
+		Items []V0045User
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectList
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045User{},
+			},
+			want: &V0045UserList{
+				Items: []V0045User{},
+			},
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0045User{
+					{V0045User: api.V0045User{Name: "test_0"}},
+					{V0045User: api.V0045User{Name: "test_1"}},
+				},
+			},
+			want: &V0045UserList{
+				Items: []V0045User{
+					{api.V0045User{Name: "test_0"}},
+					{api.V0045User{Name: "test_1"}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045UserList{
+				Items: tt.fields.Items,
+			}
+			got := o.DeepCopyObjectList()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045User_GetFlagsAsSet(t *testing.T) {
+	type fields struct {
+		V0045User api.V0045User
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   set.Set[api.V0045UserFlags]
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045User: api.V0045User{},
+			},
+			want: set.New[api.V0045UserFlags](),
+		},
+		{
+			name: "multiple",
+			fields: fields{
+				V0045User: api.V0045User{
+					Flags: &[]api.V0045UserFlags{api.V0045UserFlagsDELETED, api.V0045UserFlagsNONE},
+				},
+			},
+			want: set.New(api.V0045UserFlagsDELETED, api.V0045UserFlagsNONE),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045User{
+				V0045User: tt.fields.V0045User,
+			}
+			if got := o.GetFlagsAsSet(); !tt.want.Equal(got) {
+				t.Errorf("V0045User.GetFlagsAsSet() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestV0045User_GetDefaultAccount(t *testing.T) {
+	type fields struct {
+		V0045User api.V0045User
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   string
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045User: api.V0045User{},
+			},
+			want: "",
+		},
+		{
+			name: "default account",
+			fields: fields{
+				V0045User: api.V0045User{
+					Default: &struct {
+						Account *string `json:"account,omitempty"`
+						Qos     *int32  `json:"qos,omitempty"`
+						Wckey   *string `json:"wckey,omitempty"`
+					}{
+						Account: ptr.To("foo"),
+					},
+				},
+			},
+			want: "foo",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045User{
+				V0045User: tt.fields.V0045User,
+			}
+			got := o.GetDefaultAccount()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045User_GetCoordinatorAccounts(t *testing.T) {
+	type fields struct {
+		V0045User api.V0045User
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   []string
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045User: api.V0045User{},
+			},
+			want: []string{},
+		},
+		{
+			name: "coordinators",
+			fields: fields{
+				V0045User: api.V0045User{
+					Coordinators: &api.V0045CoordList{
+						{Name: "foo"},
+						{Name: "bar"},
+					},
+				},
+			},
+			want: []string{"foo", "bar"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045User{
+				V0045User: tt.fields.V0045User,
+			}
+			got := o.GetCoordinatorAccounts()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045User_IsCoordinatorOf(t *testing.T) {
+	type fields struct {
+		V0045User api.V0045User
+	}
+	type args struct {
+		account string
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   bool
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045User: api.V0045User{},
+			},
+			args: args{account: "foo"},
+			want: false,
+		},
+		{
+			name: "coordinator",
+			fields: fields{
+				V0045User: api.V0045User{
+					Coordinators: &api.V0045CoordList{{Name: "foo"}},
+				},
+			},
+			args: args{account: "foo"},
+			want: true,
+		},
+		{
+			name: "not coordinator",
+			fields: fields{
+				V0045User: api.V0045User{
+					Coordinators: &api.V0045CoordList{{Name: "foo"}},
+				},
+			},
+			args: args{account: "bar"},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045User{
+				V0045User: tt.fields.V0045User,
+			}
+			got := o.IsCoordinatorOf(tt.args.account)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045User_GetAccountNames(t *testing.T) {
+	type fields struct {
+		V0045User api.V0045User
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   []string
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045User: api.V0045User{},
+			},
+			want: []string{},
+		},
+		{
+			name: "associations",
+			fields: fields{
+				V0045User: api.V0045User{
+					Associations: &api.V0045AssocShortList{
+						{User: "user", Account: ptr.To("foo")},
+						{User: "user", Account: ptr.To("bar")},
+						{User: "user", Account: ptr.To("foo"), Partition: ptr.To("debug")},
+						{User: "user"},
+					},
+				},
+			},
+			want: []string{"foo", "bar"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045User{
+				V0045User: tt.fields.V0045User,
+			}
+			got := o.GetAccountNames()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045User_HasAssociation(t *testing.T) {
+	type fields struct {
+		V0045User api.V0045User
+	}
+	type args struct {
+		account   string
+		cluster   string
+		partition string
+	}
+	user := api.V0045User{
+		Associations: &api.V0045AssocShortList{
+			{User: "user", Account: ptr.To("foo"), Cluster: ptr.To("linux")},
+			{User: "user", Account: ptr.To("bar"), Cluster: ptr.To("linux"), Partition: ptr.To("debug")},
+		},
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   bool
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045User: api.V0045User{},
+			},
+			args: args{account: "foo"},
+			want: false,
+		},
+		{
+			name: "account",
+			fields: fields{
+				V0045User: user,
+			},
+			args: args{account: "foo"},
+			want: true,
+		},
+		{
+			name: "account and cluster",
+			fields: fields{
+				V0045User: user,
+			},
+			args: args{account: "foo", cluster: "linux"},
+			want: true,
+		},
+		{
+			name: "other cluster",
+			fields: fields{
+				V0045User: user,
+			},
+			args: args{account: "foo", cluster: "other"},
+			want: false,
+		},
+		{
+			name: "account and partition",
+			fields: fields{
+				V0045User: user,
+			},
+			args: args{account: "bar", partition: "debug"},
+			want: true,
+		},
+		{
+			name: "other partition",
+			fields: fields{
+				V0045User: user,
+			},
+			args: args{account: "foo", partition: "debug"},
+			want: false,
+		},
+		{
+			name: "partition only",
+			fields: fields{
+				V0045User: user,
+			},
+			args: args{account: "bar"},
+			want: false,
+		},
+		{
+			name: "other account",
+			fields: fields{
+				V0045User: user,
+			},
+			args: args{account: "baz"},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045User{
+				V0045User: tt.fields.V0045User,
+			}
+			got := o.HasAssociation(tt.args.account, tt.args.cluster, tt.args.partition)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+
+	"k8s.io/utils/ptr"
+	"k8s.io/utils/set"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+	"github.com/SlinkyProject/slurm-client/pkg/utils"
+)
+
+const (
+	ObjectTypeV0045WCKey = "V0045WCKey"
+)
+
+type V0045WCKey struct {
+	api.V0045Wckey
+}
+
+// GetKey implements Object.
+func (o *V0045WCKey) GetKey() object.ObjectKey {
+	id := ptr.Deref(o.Id, 0)
+	return object.ObjectKey(fmt.Sprintf("%d", id))
+}
+
+// GetType implements Object.
+func (o *V0045WCKey) GetType() object.ObjectType {
+	return ObjectTypeV0045WCKey
+}
+
+// DeepCopyObject implements Object.
+func (o *V0045WCKey) DeepCopyObject() object.Object {
+	return o.DeepCopy()
+}
+
+func (o *V0045WCKey) DeepCopy() *V0045WCKey {
+	out := new(V0045WCKey)
+	utils.RemarshalOrDie(o, out)
+	return out
+}
+
+func (o *V0045WCKey) GetFlagsAsSet() set.Set[api.V0045WckeyFlags] {
+	out := make(set.Set[api.V0045WckeyFlags])
+	flags := ptr.Deref(o.Flags, []api.V0045WckeyFlags{})
+	for _, f := range flags {
+		out.Insert(f)
+	}
+	return out
+}
+
+type V0045WCKeyList struct {
+	Items []V0045WCKey
+}
+
+// GetType implements ObjectList.
+func (o *V0045WCKeyList) GetType() object.ObjectType {
+	return ObjectTypeV0045WCKey
+}
+
+// GetItems implements ObjectList.
+func (o *V0045WCKeyList) GetItems() []object.Object {
+	list := make([]object.Object, len(o.Items))
+	for i, item := range o.Items {
+		list[i] = item.DeepCopyObject()
+	}
+	return list
+}
+
+// AppendItem implements ObjectList.
+func (o *V0045WCKeyList) AppendItem(object object.Object) {
+	out, ok := object.(*V0045WCKey)
+	if ok {
+		utils.RemarshalOrDie(object, out)
+		o.Items = append(o.Items, *out)
+	}
+}
+
+// DeepCopyObjectList implements ObjectList.
+func (o *V0045WCKeyList) DeepCopyObjectList() object.ObjectList {
+	out := new(V0045WCKeyList)
+	out.Items = make([]V0045WCKey, len(o.Items))
+	for i, item := range o.Items {
+		out.Items[i] = *item.DeepCopy()
+	}
+	return out
+}
@@ -0,0 +1,363 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+	"k8s.io/utils/set"
+
+	api "github.com/SlinkyProject/slurm-client/api/v0045"
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+)
+
+func TestV0045WCKey_GetKey(t *testing.T) {
+	type fields struct {
+		V0045Wckey api.V0045Wckey
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectKey
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Wckey: api.V0045Wckey{},
+			},
+			want: "0",
+		},
+		{
+			name: "key",
+			fields: fields{
+				V0045Wckey: api.V0045Wckey{Id: ptr.To[int32](1)},
+			},
+			want: "1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045WCKey{
+				V0045Wckey: tt.fields.V0045Wckey,
+			}
+			got := o.GetKey()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045WCKey_GetType(t *testing.T) {
+	type fields struct {
+		V0045Wckey api.V0045Wckey
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				V0045Wckey: api.V0045Wckey{},
+			},
+			want: ObjectTypeV0045WCKey,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045WCKey{
+				V0045Wckey: tt.fields.V0045Wckey,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045WCKey_DeepCopyObject(t *testing.T) {
+	type fields struct {
+		V0045Wckey api.V0045Wckey
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Wckey: api.V0045Wckey{},
+			},
+			want: &V0045WCKey{},
+		},
+		{
+			name: "id",
+			fields: fields{
+				V0045Wckey: api.V0045Wckey{Id: ptr.To[int32](1)},
+			},
+			want: &V0045WCKey{api.V0045Wckey{Id: ptr.To[int32](1)}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045WCKey{
+				V0045Wckey: tt.fields.V0045Wckey,
+			}
+			got := o.DeepCopyObject()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045WCKey_DeepCopy(t *testing.T) {
+	type fields struct {
+		V0045Wckey api.V0045Wckey
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   *V0045WCKey
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Wckey: api.V0045Wckey{},
+			},
+			want: &V0045WCKey{},
+		},
+		{
+			name: "id",
+			fields: fields{
+				V0045Wckey: api.V0045Wckey{Id: ptr.To[int32](1)},
+			},
+			want: &V0045WCKey{api.V0045Wckey{Id: ptr.To[int32](1)}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045WCKey{
+				V0045Wckey: tt.fields.V0045Wckey,
+			}
+			got := o.DeepCopy()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045WCKeyList_GetType(t *testing.T) {
+	type fields struct {
+		Items []V0045WCKey
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectType
+	}{
+		{
+			name: "type",
+			fields: fields{
+				Items: []V0045WCKey{},
+			},
+			want: ObjectTypeV0045WCKey,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045WCKeyList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetType()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045WCKeyList_GetItems(t *testing.T) {
+	type fields struct {
+		Items []V0045WCKey
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   []object.Object
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045WCKey{},
+			},
+			want: []object.Object{},
+		},
+		{
+			name: "items",
+			fields: fields{
+				Items: []V0045WCKey{
+					{V0045Wckey: api.V0045Wckey{Id: ptr.To[int32](1)}},
+					{V0045Wckey: api.V0045Wckey{Id: ptr.To[int32](2)}},
+				},
+			},
+			want: []object.Object{
+				&V0045WCKey{api.V0045Wckey{Id: ptr.To[int32](1)}},
+				&V0045WCKey{api.V0045Wckey{Id: ptr.To[int32](2)}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045WCKeyList{
+				Items: tt.fields.Items,
+			}
+			got := o.GetItems()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045WCKeyList_AppendItem(t *testing.T) {
+	type fields struct {
+		Items []V0045WCKey
+	}
+	type args struct {
+		object object.Object
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		args       args
+		wantAppend bool
+	}{
+		{
+			name: "nil",
+			fields: fields{
+				Items: []V0045WCKey{},
+			},
+			args: args{
+				object: nil,
+			},
+			wantAppend: false,
+		},
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045WCKey{},
+			},
+			args: args{
+				object: &V0045WCKey{},
+			},
+			wantAppend: true,
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0045WCKey{
+					{V0045Wckey: api.V0045Wckey{Id: ptr.To[int32](1)}},
+					{V0045Wckey: api.V0045Wckey{Id: ptr.To[int32](2)}},
+				},
+			},
+			args: args{
+				object: &V0045WCKey{},
+			},
+			wantAppend: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045WCKeyList{
+				Items: tt.fields.Items,
+			}
+			want := len(o.GetItems())
+			if tt.wantAppend {
+				want++
+			}
+			o.AppendItem(tt.args.object)
+			got := len(o.GetItems())
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func TestV0045WCKeyList_DeepCopyObjectList(t *testing.T) {
+	type fields struct {
+		Items []V0045WCKey
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   object.ObjectList
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				Items: []V0045WCKey{},
+			},
+			want: &V0045WCKeyList{
+				Items: []V0045WCKey{},
+			},
+		},
+		{
+			name: "existing",
+			fields: fields{
+				Items: []V0045WCKey{
+					{V0045Wckey: api.V0045Wckey{Id: ptr.To[int32](1)}},
+					{V0045Wckey: api.V0045Wckey{Id: ptr.To[int32](2)}},
+				},
+			},
+			want: &V0045WCKeyList{
+				Items: []V0045WCKey{
+					{api.V0045Wckey{Id: ptr.To[int32](1)}},
+					{api.V0045Wckey{Id: ptr.To[int32](2)}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045WCKeyList{
+				Items: tt.fields.Items,
+			}
+			got := o.DeepCopyObjectList()
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestV0045WCKey_GetFlagsAsSet(t *testing.T) {
+	type fields struct {
+		V0045Wckey api.V0045Wckey
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   set.Set[api.V0045WckeyFlags]
+	}{
+		{
+			name: "empty",
+			fields: fields{
+				V0045Wckey: api.V0045Wckey{},
+			},
+			want: set.New[api.V0045WckeyFlags](),
+		},
+		{
+			name: "multiple",
+			fields: fields{
+				V0045Wckey: api.V0045Wckey{
+					Flags: &[]api.V0045WckeyFlags{api.V0045WckeyFlagsDELETED},
+				},
+			},
+			want: set.New(api.V0045WckeyFlagsDELETED),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &V0045WCKey{
+				V0045Wckey: tt.fields.V0045Wckey,
+			}
+			if got := o.GetFlagsAsSet(); !tt.want.Equal(got) {
+				t.Errorf("V0045WCKey.GetFlagsAsSet() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+)
+
+const associationKeySeparator = "/"
+
+// AssociationKey identifies a slurmdbd association. An empty User denotes an
+// account association, an empty Partition denotes all partitions.
+type AssociationKey struct {
+	Cluster   string
+	Account   string
+	User      string
+	Partition string
+}
+
+// ObjectKey encodes the AssociationKey as "cluster/account/user/partition",
+// with each field path escaped.
+func (k AssociationKey) ObjectKey() object.ObjectKey {
+	fields := []string{
+		url.PathEscape(k.Cluster),
+		url.PathEscape(k.Account),
+		url.PathEscape(k.User),
+		url.PathEscape(k.Partition),
+	}
+	return object.ObjectKey(strings.Join(fields, associationKeySeparator))
+}
+
+// ParseAssociationKey decodes an ObjectKey created by AssociationKey.ObjectKey.
+func ParseAssociationKey(key object.ObjectKey) (AssociationKey, error) {
+	fields := strings.Split(string(key), associationKeySeparator)
+	if len(fields) != 4 {
+		return AssociationKey{}, fmt.Errorf("invalid association key %q: expected cluster/account/user/partition", key)
+	}
+	for i, field := range fields {
+		unescaped, err := url.PathUnescape(field)
+		if err != nil {
+			return AssociationKey{}, fmt.Errorf("invalid association key %q: %w", key, err)
+		}
+		fields[i] = unescaped
+	}
+	out := AssociationKey{
+		Cluster:   fields[0],
+		Account:   fields[1],
+		User:      fields[2],
+		Partition: fields[3],
+	}
+	return out, nil
+}
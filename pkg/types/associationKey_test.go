@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/SlinkyProject/slurm-client/pkg/object"
+)
+
+func TestAssociationKey_ObjectKey(t *testing.T) {
+	tests := []struct {
+		name string
+		key  AssociationKey
+		want object.ObjectKey
+	}{
+		{
+			name: "empty",
+			key:  AssociationKey{},
+			want: "///",
+		},
+		{
+			name: "account",
+			key:  AssociationKey{Cluster: "linux", Account: "root"},
+			want: "linux/root//",
+		},
+		{
+			name: "user and partition",
+			key:  AssociationKey{Cluster: "linux", Account: "root", User: "slurm", Partition: "debug"},
+			want: "linux/root/slurm/debug",
+		},
+		{
+			name: "escaped",
+			key:  AssociationKey{Cluster: "linux", Account: "a/b", User: "c%d"},
+			want: "linux/a%2Fb/c%25d/",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.key.ObjectKey()
+			require.Equal(t, tt.want, got)
+
+			parsed, err := ParseAssociationKey(got)
+			require.NoError(t, err)
+			require.Equal(t, tt.key, parsed)
+		})
+	}
+}
+
+func TestParseAssociationKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     object.ObjectKey
+		want    AssociationKey
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			key:  "linux/root/slurm/",
+			want: AssociationKey{Cluster: "linux", Account: "root", User: "slurm"},
+		},
+		{
+			name:    "too few fields",
+			key:     "linux/root",
+			wantErr: true,
+		},
+		{
+			name:    "too many fields",
+			key:     "linux/root/slurm/debug/extra",
+			wantErr: true,
+		},
+		{
+			name:    "bad escape",
+			key:     "linux/root/%zz/",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAssociationKey(tt.key)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseAssociationKey() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
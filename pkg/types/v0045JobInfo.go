@@ -53,6 +53,19 @@ func (o *V0045JobInfo) GetStateAsSet() set.Set[api.V0045JobInfoJobState] {
 	return out
 }
 
+// GetAllocatedNodes returns the names of the nodes allocated to the job.
+func (o *V0045JobInfo) GetAllocatedNodes() []string {
+	out := []string{}
+	if o.JobResources == nil || o.JobResources.Nodes == nil {
+		return out
+	}
+	allocation := ptr.Deref(o.JobResources.Nodes.Allocation, api.V0045JobResNodes{})
+	for _, node := range allocation {
+		out = append(out, node.Name)
+	}
+	return out
+}
+
 type V0045JobInfoList struct {
 	Items []V0045JobInfo
 }
@@ -6,7 +6,10 @@ package utils
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"regexp"
+	"strconv"
+	"strings"
 )
 
 func Remarshal(in any, out any) error {
@@ -23,6 +26,14 @@ func RemarshalOrDie(in any, out any) {
 	}
 }
 
+// StringPtrOrNil returns a pointer to s, or nil if s is empty.
+func StringPtrOrNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
 func ParseNodeName(nodeConf string) (string, error) {
 	re := regexp.MustCompile(`(?i)NodeName=([^\s]+)`)
 	matches := re.FindStringSubmatch(nodeConf)
@@ -31,3 +42,123 @@ func ParseNodeName(nodeConf string) (string, error) {
 	}
 	return matches[1], nil
 }
+
+// MaxHostlistSize is the most host names a hostlist expression may expand to,
+// matching the range limit of Slurm.
+const MaxHostlistSize = 64 * 1024
+
+// ExpandHostlist expands a Slurm hostlist expression (e.g. "node[01-03,05],gpu0")
+// into the list of host names. It returns an error if the expression expands to
+// more than MaxHostlistSize host names.
+func ExpandHostlist(hostlist string) ([]string, error) {
+	hosts := []string{}
+	depth := 0
+	start := 0
+	for i, c := range hostlist {
+		switch c {
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unbalanced brackets in hostlist: %s", hostlist)
+			}
+		case ',':
+			if depth == 0 {
+				expanded, err := expandHost(hostlist[start:i])
+				if err != nil {
+					return nil, err
+				}
+				if len(hosts)+len(expanded) > MaxHostlistSize {
+					return nil, errHostlistTooLarge(hostlist)
+				}
+				hosts = append(hosts, expanded...)
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced brackets in hostlist: %s", hostlist)
+	}
+	expanded, err := expandHost(hostlist[start:])
+	if err != nil {
+		return nil, err
+	}
+	if len(hosts)+len(expanded) > MaxHostlistSize {
+		return nil, errHostlistTooLarge(hostlist)
+	}
+	return append(hosts, expanded...), nil
+}
+
+func errHostlistTooLarge(hostlist string) error {
+	return fmt.Errorf("hostlist expands to more than %d hosts: %s", MaxHostlistSize, hostlist)
+}
+
+// expandHost expands a single host expression, which may contain multiple
+// bracketed range sets (e.g. "rack[1-2]-node[1-4]").
+func expandHost(host string) ([]string, error) {
+	host = strings.TrimSpace(host)
+	if host == "" {
+		return nil, nil
+	}
+	open := strings.Index(host, "[")
+	if open < 0 {
+		return []string{host}, nil
+	}
+	end := strings.Index(host[open:], "]")
+	if end < 0 {
+		return nil, fmt.Errorf("unbalanced brackets in host: %s", host)
+	}
+	end += open
+	prefix := host[:open]
+	suffixes, err := expandHost(host[end+1:])
+	if err != nil {
+		return nil, err
+	}
+	if len(suffixes) == 0 {
+		suffixes = []string{""}
+	}
+	hosts := []string{}
+	for _, rng := range strings.Split(host[open+1:end], ",") {
+		ids, err := expandRange(rng)
+		if err != nil {
+			return nil, err
+		}
+		if len(hosts)+len(ids)*len(suffixes) > MaxHostlistSize {
+			return nil, errHostlistTooLarge(host)
+		}
+		for _, id := range ids {
+			for _, suffix := range suffixes {
+				hosts = append(hosts, prefix+id+suffix)
+			}
+		}
+	}
+	return hosts, nil
+}
+
+// expandRange expands a numeric range (e.g. "01-03"), preserving zero padding.
+func expandRange(rng string) ([]string, error) {
+	lo, hi, found := strings.Cut(rng, "-")
+	if !found {
+		if _, err := strconv.Atoi(lo); err != nil {
+			return nil, fmt.Errorf("invalid hostlist range: %s", rng)
+		}
+		return []string{lo}, nil
+	}
+	first, err := strconv.Atoi(lo)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hostlist range: %s", rng)
+	}
+	last, err := strconv.Atoi(hi)
+	if err != nil || last < first {
+		return nil, fmt.Errorf("invalid hostlist range: %s", rng)
+	}
+	if last-first >= MaxHostlistSize {
+		return nil, errHostlistTooLarge(rng)
+	}
+	ids := make([]string, 0, last-first+1)
+	for i := first; i <= last; i++ {
+		ids = append(ids, fmt.Sprintf("%0*d", len(lo), i))
+	}
+	return ids, nil
+}
@@ -4,6 +4,7 @@
 package utils
 
 import (
+	"slices"
 	"testing"
 
 	"k8s.io/utils/ptr"
@@ -124,6 +125,15 @@ func TestRemarshalOrDie(t *testing.T) {
 	}
 }
 
+func TestStringPtrOrNil(t *testing.T) {
+	if got := StringPtrOrNil(""); got != nil {
+		t.Errorf("StringPtrOrNil() = %v, want nil", *got)
+	}
+	if got := StringPtrOrNil("foo"); got == nil || *got != "foo" {
+		t.Errorf("StringPtrOrNil() = %v, want foo", got)
+	}
+}
+
 func TestParseNodeName(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -175,3 +185,97 @@ func TestParseNodeName(t *testing.T) {
 		})
 	}
 }
+
+func TestExpandHostlist(t *testing.T) {
+	tests := []struct {
+		name     string
+		hostlist string
+		want     []string
+		wantErr  bool
+	}{
+		{
+			name:     "Empty string",
+			hostlist: "",
+			want:     []string{},
+			wantErr:  false,
+		},
+		{
+			name:     "Single host",
+			hostlist: "node-0",
+			want:     []string{"node-0"},
+			wantErr:  false,
+		},
+		{
+			name:     "List of hosts",
+			hostlist: "node-0,node-1, gpu-0",
+			want:     []string{"node-0", "node-1", "gpu-0"},
+			wantErr:  false,
+		},
+		{
+			name:     "Range",
+			hostlist: "node[1-3]",
+			want:     []string{"node1", "node2", "node3"},
+			wantErr:  false,
+		},
+		{
+			name:     "Zero padded ranges and list",
+			hostlist: "node[08-10,12],gpu0",
+			want:     []string{"node08", "node09", "node10", "node12", "gpu0"},
+			wantErr:  false,
+		},
+		{
+			name:     "Multiple range sets",
+			hostlist: "rack[1-2]-node[1-2]",
+			want:     []string{"rack1-node1", "rack1-node2", "rack2-node1", "rack2-node2"},
+			wantErr:  false,
+		},
+		{
+			name:     "Unbalanced brackets",
+			hostlist: "node[1-3",
+			want:     nil,
+			wantErr:  true,
+		},
+		{
+			name:     "Invalid range",
+			hostlist: "node[3-1]",
+			want:     nil,
+			wantErr:  true,
+		},
+		{
+			name:     "Non numeric range",
+			hostlist: "node[a-c]",
+			want:     nil,
+			wantErr:  true,
+		},
+		{
+			name:     "Range too large",
+			hostlist: "node[0-999999999]",
+			want:     nil,
+			wantErr:  true,
+		},
+		{
+			name:     "Bracket sets too large",
+			hostlist: "rack[1-1000]-node[1-1000]",
+			want:     nil,
+			wantErr:  true,
+		},
+		{
+			name:     "Hostlist too large",
+			hostlist: "a[1-40000],b[1-40000]",
+			want:     nil,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ExpandHostlist(tt.hostlist)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ExpandHostlist() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ExpandHostlist() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+// SPDX-FileCopyrightText: Copyright (C) SchedMD LLC.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package version names the Slurm REST API data parser versions implemented by
+// this module.
+package version
+
+// Slurm REST API data parser versions.
+const (
+	V0042 = "v0.0.42"
+	V0043 = "v0.0.43"
+	V0044 = "v0.0.44"
+	V0045 = "v0.0.45"
+)
+
+// Known returns the versions implemented by this module, oldest first.
+func Known() []string {
+	return []string{
+		V0042,
+		V0043,
+		V0044,
+		V0045,
+	}
+}